@@ -0,0 +1,71 @@
+package enum
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Prepares and checks every enum in an application's config struct in one pass. Unset enums
+// are given the Const marked default in their enum tag, then, with the Env option, each enum is
+// read from the environment as LoadEnv would. Every enum is validated last. All problems are
+// reported in a *MultiError, naming each enum by its dotted field path or, for values read
+// from the environment, its variable. Flags bound with enum.Flag are validated as they are
+// parsed, so parse them afterwards for them to take precedence over the environment
+//   type Config struct {
+//     Billing struct {
+//       Currency CurrencyCodes `json:"currency"`
+//     } `json:"billing"`
+//   }
+//
+//   err := enum.ValidateConfig(&cfg, enum.Env("APP"))
+//   fmt.Println(err) // Prints "billing.currency: Random is not a valid enum"
+func ValidateConfig(cfg interface{}, opts ...Option) error {
+	o := newOptions(opts)
+	var errs []error
+	fail := func(path string, err error) {
+		if path != "" {
+			err = &FieldError{Field: path, Err: err}
+		}
+		errs = append(errs, err)
+	}
+	err := walk(reflect.ValueOf(cfg), "", jsonName, func(path string, e Enummer) error {
+		if err := ensureConstructed(e); err != nil {
+			fail(path, err)
+		} else if c, ok := e.base().Default(); ok && e.Get() == "" {
+			e.unsafeSet(c)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if o.env {
+		err = walk(reflect.ValueOf(cfg), o.envPrefix, envName, func(path string, e Enummer) error {
+			if e.base().constructed() && !strings.Contains(path, "[") {
+				if err := FromEnv(e, strings.ReplaceAll(path, ".", "_"), opts...); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	err = walk(reflect.ValueOf(cfg), "", jsonName, func(path string, e Enummer) error {
+		if !e.base().constructed() {
+			return nil
+		}
+		if err := Validate(e, opts...); err != nil {
+			fail(path, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &MultiError{Errors: errs}
+}
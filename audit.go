@@ -0,0 +1,83 @@
+package enum
+
+import (
+	"context"
+	"time"
+)
+
+type actorKey struct{}
+
+// A change made through an Audited enum
+type AuditRecord struct {
+	// The name of the enum type
+	Type string
+	Old  Const
+	New  Const
+	At   time.Time
+	// Who made the change, taken from the context passed to Set, see WithActor
+	Actor string
+}
+
+// Receives the AuditRecords of Audited enums, such as to append them to a history table
+type AuditSink interface {
+	Record(ctx context.Context, r AuditRecord) error
+}
+
+// Adapts a function to an AuditSink
+type AuditSinkFunc func(ctx context.Context, r AuditRecord) error
+
+func (f AuditSinkFunc) Record(ctx context.Context, r AuditRecord) error {
+	return f(ctx, r)
+}
+
+// Wraps an enum so every Set made through it is recorded in a sink, allowing the history of
+// the value to be reconstructed. Sets made on the enum directly aren't recorded
+//   status := enum.NewAudited(order.Status, sink)
+//   err := status.Set(enum.WithActor(ctx, "alice"), order.Status.Shipped)
+type Audited[T Enummer] struct {
+	e    T
+	sink AuditSink
+}
+
+// Wraps e so every Set made through the wrapper is recorded in sink
+func NewAudited[T Enummer](e T, sink AuditSink) *Audited[T] {
+	return &Audited[T]{e: e, sink: sink}
+}
+
+// The wrapped enum
+func (a *Audited[T]) Enum() T {
+	return a.e
+}
+
+// Gets the value stored on the wrapped enum
+func (a *Audited[T]) Get() Const {
+	return a.e.Get()
+}
+
+// Sets the value on the wrapped enum, see Enum.Set, then records the change in the sink.
+// The record holds the value as stored, so setting an alias records the Const it stands for.
+// Nothing is recorded if the Set fails. The error from the sink is returned, in which case
+// the value has already been stored
+func (a *Audited[T]) Set(ctx context.Context, c Const) error {
+	old := a.e.Get()
+	if err := a.e.Set(c); err != nil {
+		return err
+	}
+	r := AuditRecord{Old: old, New: a.e.Get(), At: time.Now(), Actor: ActorFrom(ctx)}
+	if d, err := Describe(a.e); err == nil {
+		r.Type = d.name
+	}
+	return a.sink.Record(ctx, r)
+}
+
+// Returns a copy of ctx naming who is acting, which Audited enums record with each change
+//   ctx = enum.WithActor(ctx, user.Email)
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// The actor stored on ctx by WithActor, empty if there is none
+func ActorFrom(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
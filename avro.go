@@ -0,0 +1,52 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"regexp"
+)
+
+const invalidAvroSymbolErrorMsg = "%s of %s is not a valid Avro symbol, which must match [A-Za-z_][A-Za-z0-9_]*"
+
+var avroSymbolRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// The Avro enum schema for the enum, with one symbol per Const in declaration order. Retired
+// Consts are kept as symbols so data written before they were retired can still be read.
+// Panics if the enum is declared incorrectly or a Const isn't a valid Avro symbol
+//   fmt.Println(enum.AvroSchema(new(CurrencyCodes)))
+//   // Prints {"type":"enum","name":"CurrencyCodes","symbols":["USD","CUSTOM"]}
+func AvroSchema(e Enummer) string {
+	out, err := AvroSchemaE(e)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// The Avro enum schema for the enum, see AvroSchema. Returns an error rather than panicking
+// if e is nil or declared incorrectly, or if a Const isn't a valid Avro symbol
+func AvroSchemaE(e Enummer) (string, error) {
+	d, err := Describe(e)
+	if err != nil {
+		return "", err
+	}
+	t := d.table()
+	symbols := make([]Const, len(t.consts))
+	for i, c := range t.consts {
+		if !avroSymbolRegex.MatchString(string(c.value)) {
+			return "", errors.New(fmt.Sprintf(invalidAvroSymbolErrorMsg, c.value, d.name))
+		}
+		symbols[i] = c.value
+	}
+	out, _ := json.Marshal(struct {
+		Type    string  `json:"type"`
+		Name    string  `json:"name"`
+		Symbols []Const `json:"symbols"`
+	}{
+		Type:    "enum",
+		Name:    d.name,
+		Symbols: symbols,
+	})
+	return string(out), nil
+}
@@ -0,0 +1,55 @@
+package enum
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+const binaryNotConstructedErrorMsg = "cannot use the binary encoding on an enum that has not been constructed"
+const binaryOrdinalErrorMsg = "ordinal %d is out of range for %s"
+const binaryMalformedErrorMsg = "malformed binary enum"
+
+// Implements encoding.BinaryMarshaler, encoding the enum as a varint of its ordinal plus one
+// so that zero stands for no value. Most enums take a single byte. The ordinal depends on the
+// order Consts are declared in so reordering them, which changes the Descriptor's
+// Fingerprint, invalidates stored values. Returns an error if the enum hasn't been
+// constructed or its value is invalid or retired
+func (e Enum) MarshalBinary() ([]byte, error) {
+	if e.desc == nil {
+		return nil, errors.New(binaryNotConstructedErrorMsg)
+	}
+	if e.val == "" {
+		return []byte{0}, nil
+	}
+	t := e.desc.table()
+	i := t.index(e.val)
+	if i < 0 || t.consts[i].retired {
+		return nil, e.desc.invalid(e.val)
+	}
+	return binary.AppendUvarint(nil, uint64(i)+1), nil
+}
+
+// Implements encoding.BinaryUnmarshaler, decoding an ordinal written by MarshalBinary. The
+// enum must be constructed first so the ordinal can be resolved
+//   cc := enum.New(new(CurrencyCodes)).(*CurrencyCodes)
+//   err := cc.UnmarshalBinary(b)
+func (e *Enum) UnmarshalBinary(b []byte) error {
+	if e.desc == nil {
+		return errors.New(binaryNotConstructedErrorMsg)
+	}
+	n, size := binary.Uvarint(b)
+	if size <= 0 || size != len(b) {
+		return errors.New(binaryMalformedErrorMsg)
+	}
+	if n == 0 {
+		e.unsafeSet("")
+		return nil
+	}
+	t := e.desc.table()
+	if n > uint64(len(t.consts)) {
+		return errors.New(fmt.Sprintf(binaryOrdinalErrorMsg, n-1, e.desc.name))
+	}
+	e.unsafeSet(t.consts[n-1].value)
+	return nil
+}
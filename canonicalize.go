@@ -0,0 +1,27 @@
+package enum
+
+// Replaces the value held by the enum with the Const it stands for, constructing the enum
+// first if need be. Set and unmarshalling already store aliases and legacy values as their
+// Const, so this is for values stored some other way, such as ones read in before a rename.
+// Options such as CaseInsensitive and Normalize widen what is matched. An enum without a
+// value is left alone. Returns an error if the value doesn't match any valid Const
+//   cc := enum.New(new(CurrencyCodes)).(*CurrencyCodes)
+//   json.Unmarshal([]byte(`"usd"`), cc)
+//
+//   err := enum.Canonicalize(cc, enum.CaseInsensitive())
+//   fmt.Println(cc.Get()) // Prints "USD"
+func Canonicalize(e Enummer, opts ...Option) error {
+	if err := ensureConstructed(e); err != nil {
+		return err
+	}
+	b := e.base()
+	if b.val == "" {
+		return nil
+	}
+	c, ok := b.desc.resolve(string(b.val), newOptions(opts))
+	if !ok {
+		return b.desc.invalid(b.val)
+	}
+	e.unsafeSet(c)
+	return nil
+}
@@ -0,0 +1,12 @@
+// Runs the go-enum analyzers, see package enumvet
+//   go vet -vettool=$(which enumvet) ./...
+package main
+
+import (
+	"go-enum/enumvet"
+	"golang.org/x/tools/go/analysis/multichecker"
+)
+
+func main() {
+	multichecker.Main(enumvet.Analyzers...)
+}
@@ -0,0 +1,227 @@
+// Generates go-enum structs from other definitions of the same enums and exports go-enum
+// structs to other languages, see package enumgen
+//   goenum import openapi [-pkg name] [-o file] spec.yaml
+//   goenum import pg -dsn dsn [-schema name] [-pkg name] [-o file]
+//   goenum import jsonschema [-pkg name] [-o file] schema.json
+//   goenum export ts [-d] [-o file] [packages]
+//   goenum export sql -dialect postgres [-dsn dsn] [-schema name] [-o file] [packages]
+//   goenum export proto [-pkg name] [-o file] [packages]
+//   goenum docs [-html] [-o file] [packages]
+//   goenum -stringer -type name -enum name [-o file] [package]
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"go-enum/enumgen"
+	"io"
+	"os"
+	"strings"
+)
+
+const usage = `usage: goenum import openapi [-pkg name] [-o file] spec.yaml
+       goenum import pg -dsn dsn [-schema name] [-pkg name] [-o file]
+       goenum import jsonschema [-pkg name] [-o file] schema.json
+       goenum export ts [-d] [-o file] [packages]
+       goenum export sql -dialect postgres [-dsn dsn] [-schema name] [-o file] [packages]
+       goenum export proto [-pkg name] [-o file] [packages]
+       goenum docs [-html] [-o file] [packages]
+       goenum -stringer -type name -enum name [-o file] [package]`
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "goenum:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) > 0 && args[0] == "docs" {
+		return runExport("docs", args[1:])
+	}
+	if len(args) > 0 && (args[0] == "-stringer" || args[0] == "--stringer") {
+		return runStringer(args[1:])
+	}
+	if len(args) < 2 {
+		return fmt.Errorf(usage)
+	}
+	switch args[0] {
+	case "import":
+		return runImport(args[1], args[2:])
+	case "export":
+		return runExport(args[1], args[2:])
+	}
+	return fmt.Errorf(usage)
+}
+
+// Generates structs for the enums of the source called source, configured by args.
+func runImport(source string, args []string) error {
+	fs := flag.NewFlagSet("import "+source, flag.ContinueOnError)
+	pkg := fs.String("pkg", "enums", "the package of the generated file")
+	out := fs.String("o", "", "the file to write, stdout if empty")
+	dsn := fs.String("dsn", "", "the database to read enum types from, for pg")
+	schema := fs.String("schema", "", "the schema to read enum types from, every schema if empty, for pg")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var enums []enumgen.Enum
+	switch source {
+	case "openapi", "jsonschema":
+		if fs.NArg() != 1 {
+			return fmt.Errorf(usage)
+		}
+		b, err := os.ReadFile(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		from := enumgen.FromOpenAPI
+		if source == "jsonschema" {
+			from = enumgen.FromJSONSchema
+		}
+		if enums, err = from(b); err != nil {
+			return err
+		}
+	case "pg":
+		if *dsn == "" || fs.NArg() != 0 {
+			return fmt.Errorf(usage)
+		}
+		var err error
+		if enums, err = readPostgres(*dsn, *schema); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown source %q\n%s", source, usage)
+	}
+	return write(*out, func(w io.Writer) error {
+		return enumgen.Generate(w, *pkg, enums)
+	})
+}
+
+// Exports the enums declared in the packages named by args to the format called format, which
+// includes their docs.
+func runExport(format string, args []string) error {
+	fs := flag.NewFlagSet("export "+format, flag.ContinueOnError)
+	out := fs.String("o", "", "the file to write, stdout if empty")
+	pkg := fs.String("pkg", "", "the package of the generated file, for proto")
+	html := fs.Bool("html", false, "write HTML rather than Markdown, for docs")
+	decl := fs.Bool("d", false, "write a declaration file, for ts")
+	dialect := fs.String("dialect", "", "the SQL dialect, for sql")
+	dsn := fs.String("dsn", "", "the database to diff against, for sql")
+	schema := fs.String("schema", "", "the schema to diff against, every schema if empty, for sql")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+	enums, err := enumgen.Load(patterns...)
+	if err != nil {
+		return err
+	}
+
+	var export func(w io.Writer, enums []enumgen.Enum) error
+	switch format {
+	case "ts":
+		export = enumgen.TypeScript
+		if *decl {
+			export = enumgen.TypeScriptDeclarations
+		}
+	case "docs":
+		export = enumgen.Markdown
+		if *html {
+			export = enumgen.HTML
+		}
+	case "proto":
+		export = func(w io.Writer, enums []enumgen.Enum) error {
+			return enumgen.Proto(w, *pkg, enums)
+		}
+	case "sql":
+		existing, err := readPostgres(*dsn, *schema)
+		if err != nil {
+			return err
+		}
+		export = func(w io.Writer, enums []enumgen.Enum) error {
+			return enumgen.SQL(w, *dialect, enums, existing)
+		}
+	default:
+		return fmt.Errorf("unknown format %q\n%s", format, usage)
+	}
+	return write(*out, func(w io.Writer) error {
+		return export(w, enums)
+	})
+}
+
+// Generates the integer type named by -type from the enum struct named by -enum, in the
+// manner of stringer. The file defaults to the type's name in lower case followed by
+// _string.go, as stringer's does.
+func runStringer(args []string) error {
+	fs := flag.NewFlagSet("-stringer", flag.ContinueOnError)
+	typ := fs.String("type", "", "the integer type to generate")
+	name := fs.String("enum", "", "the enum struct declaring the type's values")
+	out := fs.String("o", "", "the file to write, type_string.go if empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *typ == "" || *name == "" || fs.NArg() > 1 {
+		return fmt.Errorf(usage)
+	}
+	pattern := "."
+	if fs.NArg() == 1 {
+		pattern = fs.Arg(0)
+	}
+	enums, err := enumgen.Load(pattern)
+	if err != nil {
+		return err
+	}
+	for _, e := range enums {
+		if e.Name != *name {
+			continue
+		}
+		if *out == "" {
+			*out = strings.ToLower(*typ) + "_string.go"
+		}
+		return write(*out, func(w io.Writer) error {
+			return enumgen.Stringer(w, *typ, e)
+		})
+	}
+	return fmt.Errorf("no enum struct called %s in %s", *name, pattern)
+}
+
+// Reads the enum types of the Postgres database at dsn, limited to schema if it isn't empty.
+// Reads nothing if dsn is empty.
+func readPostgres(dsn, schema string) ([]enumgen.Enum, error) {
+	if dsn == "" {
+		return nil, nil
+	}
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	var schemas []string
+	if schema != "" {
+		schemas = append(schemas, schema)
+	}
+	return enumgen.FromPostgres(context.Background(), db, schemas...)
+}
+
+// Writes to the file at path, or stdout if path is empty.
+func write(path string, fn func(w io.Writer) error) error {
+	if path == "" {
+		return fn(os.Stdout)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := fn(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
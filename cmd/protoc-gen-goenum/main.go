@@ -0,0 +1,12 @@
+// A protoc plugin generating go-enum structs from proto enums, see package enumproto
+//   protoc --go_out=. --goenum_out=. status.proto
+package main
+
+import (
+	"go-enum/enumproto"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func main() {
+	protogen.Options{}.Run(enumproto.Generate)
+}
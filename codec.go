@@ -0,0 +1,119 @@
+package enum
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+)
+
+// Codecs keyed by the *Descriptor of the enum they encode.
+var codecs sync.Map
+
+// Converts Consts to and from a bespoke wire format, such as legacy fixed width codes or
+// values suffixed with a checksum. Register one for an enum type with RegisterCodec
+type Codec interface {
+	Encode(c Const) ([]byte, error)
+	Decode(b []byte) (Const, error)
+}
+
+// Makes every enum of e's type marshal to and unmarshal from JSON strings and text through c
+// rather than using its values as they are. The codec replaces the enum's format tag. Codecs
+// are only used for enums which have been constructed, except that Validate decodes values
+// unmarshalled before then. Pass a nil Codec to go back to the default encoding
+//   err := enum.RegisterCodec(new(CurrencyCodes), legacyCodes{})
+//   out, _ := json.Marshal(cc)
+//   fmt.Println(string(out)) // Prints "840" rather than "USD"
+func RegisterCodec(e Enummer, c Codec) error {
+	d, err := Describe(e)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		codecs.Delete(d)
+		return nil
+	}
+	codecs.Store(d, c)
+	return nil
+}
+
+// The Codec registered for the enum type, or the type a restricted enum was made from, if any.
+func (d *Descriptor) codec() (Codec, bool) {
+	if d == nil {
+		return nil, false
+	}
+	if d.restrictedFrom != nil {
+		d = d.restrictedFrom
+	}
+	c, ok := codecs.Load(d)
+	if !ok {
+		return nil, false
+	}
+	return c.(Codec), true
+}
+
+// Marshals the value into a JSON string through the codec.
+func (e Enum) marshalCodec(c Codec) ([]byte, error) {
+	if e.val == "" {
+		return []byte(`""`), nil
+	}
+	b, err := c.Encode(e.val)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strconv.Quote(string(b))), nil
+}
+
+// Unmarshals a JSON string through the codec.
+func (e *Enum) unmarshalCodec(c Codec, b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		e.unsafeSet("")
+		return nil
+	}
+	v, err := c.Decode([]byte(s))
+	if err != nil {
+		return err
+	}
+	e.unsafeSet(v)
+	return nil
+}
+
+// Decodes a value which was unmarshalled before the enum was constructed, and so before its
+// codec, format and loose tag were known. A JSON number or object is stored as it was
+// unmarshalled, so it is unmarshalled again if the enum reads it as a code or object, while
+// strings which are already valid are left alone.
+func (e *Enum) decodePending() error {
+	if e.val == "" || e.desc.has(e.val) {
+		return nil
+	}
+	if c, ok := e.desc.codec(); ok {
+		if v, err := c.Decode([]byte(e.val)); err == nil {
+			e.val = v
+		}
+		return nil
+	}
+	if b := []byte(e.val); e.desc.readsJSON(b) {
+		return e.UnmarshalJSON(b)
+	}
+	if e.desc.loose {
+		return e.unmarshalLoose([]byte(strconv.Quote(string(e.val))))
+	}
+	return nil
+}
+
+// Whether the enum reads the JSON b as a code or object rather than only as a string.
+func (d *Descriptor) readsJSON(b []byte) bool {
+	if len(b) == 0 || !json.Valid(b) {
+		return false
+	}
+	if b[0] == '{' {
+		return d.format == objectFormat
+	}
+	if b[0] == '-' || (b[0] >= '0' && b[0] <= '9') {
+		return d.loose || d.format == intFormat || d.format == protoFormat
+	}
+	return false
+}
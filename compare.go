@@ -0,0 +1,115 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+const notOrderedErrorMsg = "%s is not ordered, tag its enum.Enum with ordered to compare its values"
+
+// Compares a and b by their position in GetAll, returning -1 if a comes first, 1 if b does
+// and 0 if they are the same. Only enums whose embedded Enum is tagged ordered can be
+// compared. Panics if the enum isn't ordered or constructed, or if a or b isn't on it
+//   type Severity struct {
+//     enum.Enum `ordered:""`
+//     Low       enum.Const `enum:"LOW"`
+//     Medium    enum.Const `enum:"MEDIUM"`
+//     High      enum.Const `enum:"HIGH"`
+//   }
+//
+//   if sev.Less(sev.Get(), sev.High) { ... }
+func (e *Enum) Compare(a, b Const) int {
+	i, j := e.rank(a), e.rank(b)
+	switch {
+	case i < j:
+		return -1
+	case i > j:
+		return 1
+	}
+	return 0
+}
+
+// Whether a comes before b, see Compare
+func (e *Enum) Less(a, b Const) bool {
+	return e.Compare(a, b) < 0
+}
+
+// Whether a comes before b or is b, see Compare
+func (e *Enum) LessEqual(a, b Const) bool {
+	return e.Compare(a, b) <= 0
+}
+
+// Whether a comes after b, see Compare
+func (e *Enum) Greater(a, b Const) bool {
+	return e.Compare(a, b) > 0
+}
+
+// The last of cs, see Compare. Returns an empty Const if cs is empty
+//   fmt.Println(sev.Max(sev.Low, sev.High, sev.Medium)) // Prints "HIGH"
+func (e *Enum) Max(cs ...Const) Const {
+	return e.extreme(cs, 1)
+}
+
+// The first of cs, see Compare. Returns an empty Const if cs is empty
+func (e *Enum) Min(cs ...Const) Const {
+	return e.extreme(cs, -1)
+}
+
+func (e *Enum) extreme(cs []Const, sign int) Const {
+	var out Const
+	for i, c := range cs {
+		if i == 0 || e.Compare(c, out) == sign {
+			out = c
+		}
+	}
+	return out
+}
+
+// Whether the enum's value lies between lo and hi, inclusive, see Compare. An enum without a
+// value is never between them. Returns an error if the enum isn't ordered or constructed, or
+// if lo, hi or the enum's value isn't on it
+//   shipping, err := status.Between(status.Shipped, status.Delivered)
+func (e *Enum) Between(lo, hi Const) (bool, error) {
+	i, err := e.rankE(lo)
+	if err != nil {
+		return false, err
+	}
+	j, err := e.rankE(hi)
+	if err != nil {
+		return false, err
+	}
+	if e.val == "" {
+		return false, nil
+	}
+	k, err := e.rankE(e.val)
+	if err != nil {
+		return false, err
+	}
+	return i <= k && k <= j, nil
+}
+
+// The ordinal of c. Panics if it can't be compared.
+func (e *Enum) rank(c Const) int {
+	i, err := e.rankE(c)
+	if err != nil {
+		panic(err.Error())
+	}
+	return i
+}
+
+// The ordinal of c, or of the Const it is an alias or legacy value of. Returns an error if
+// it can't be compared.
+func (e *Enum) rankE(c Const) (int, error) {
+	if e.desc == nil {
+		return -1, errors.New(enumNotConstructedErrorMsg)
+	}
+	if !e.desc.ordered {
+		return -1, errors.New(fmt.Sprintf(notOrderedErrorMsg, e.desc.name))
+	}
+	t := e.desc.table()
+	i := t.index(t.canonical(c))
+	if i < 0 {
+		return -1, e.desc.invalid(c)
+	}
+	return i, nil
+}
@@ -0,0 +1,58 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"sort"
+	"strings"
+)
+
+const incompleteMapErrorMsg = "map for %s is missing entries for %s"
+
+// Checks that m has an entry for every Const which can be set on e, deprecated ones included,
+// so lookup tables keyed by an enum can't silently drift when Consts are added. Returns an
+// error naming the missing Consts, or if a key isn't on the enum
+//   err := enum.CompleteMap(new(CurrencyCodes), map[enum.Const]string{
+//     "USD":    "$",
+//     "CUSTOM": "¤",
+//   })
+func CompleteMap[V any](e Enummer, m map[Const]V) error {
+	d, err := Describe(e)
+	if err != nil {
+		return err
+	}
+	keys := make([]Const, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	t := d.table()
+	for _, k := range keys {
+		if c := t.lookup(k); c == nil || c.retired {
+			return d.invalid(k)
+		}
+	}
+	var missing []string
+	for _, c := range t.consts {
+		if _, ok := m[c.value]; !ok && !c.retired {
+			missing = append(missing, string(c.value))
+		}
+	}
+	if len(missing) > 0 {
+		return errors.New(fmt.Sprintf(incompleteMapErrorMsg, d.name, strings.Join(missing, ", ")))
+	}
+	return nil
+}
+
+// Returns m after checking it with CompleteMap. Panics if m is incomplete, so it suits
+// package level lookup tables
+//   var symbols = enum.MustCompleteMap(new(CurrencyCodes), map[enum.Const]string{
+//     "USD":    "$",
+//     "CUSTOM": "¤",
+//   })
+func MustCompleteMap[V any](e Enummer, m map[Const]V) map[Const]V {
+	if err := CompleteMap(e, m); err != nil {
+		panic(err.Error())
+	}
+	return m
+}
@@ -0,0 +1,174 @@
+package enum
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var shellIdentRegex = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// Characters which are left alone when a value is escaped for compgen.
+var bashSafeRegex = regexp.MustCompile(`^[A-Za-z0-9_.,:/@%+=-]$`)
+
+// A bash completion script which completes each flag of command with the values of its enum.
+// Flags can be given with or without their leading dashes. Deprecated and retired Consts are
+// never suggested. Returns an error if an enum is declared incorrectly
+//   script, err := enum.BashCompletion("pay", map[string]enum.Enummer{
+//     "currency": new(CurrencyCodes),
+//     "tender":   new(Tender),
+//   })
+//   fmt.Println(script)
+//   // Prints
+//   // _pay() {
+//   //   local cur="${COMP_WORDS[COMP_CWORD]}" prev="${COMP_WORDS[COMP_CWORD-1]}"
+//   //   case "$prev" in
+//   //     '--currency')
+//   //       mapfile -t COMPREPLY < <(compgen -W 'USD EUR' -- "$cur")
+//   //       ;;
+//   //     '--tender')
+//   //       mapfile -t COMPREPLY < <(compgen -W 'CASH CARD' -- "$cur")
+//   //       ;;
+//   //   esac
+//   // }
+//   // complete -o default -F _pay 'pay'
+func BashCompletion(command string, flags map[string]Enummer) (string, error) {
+	fs, err := completionFlags(flags)
+	if err != nil {
+		return "", err
+	}
+	fn := shellIdentRegex.ReplaceAllString("_"+command, "_")
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s() {\n", fn)
+	b.WriteString("  local cur=\"${COMP_WORDS[COMP_CWORD]}\" prev=\"${COMP_WORDS[COMP_CWORD-1]}\"\n")
+	b.WriteString("  case \"$prev\" in\n")
+	for _, f := range fs {
+		var values []string
+		for _, c := range f.consts {
+			values = append(values, bashEscape(string(c.value)))
+		}
+		fmt.Fprintf(&b, "    %s)\n", shellQuote("--"+f.name))
+		fmt.Fprintf(&b, "      mapfile -t COMPREPLY < <(compgen -W %s -- \"$cur\")\n", shellQuote(strings.Join(values, " ")))
+		b.WriteString("      ;;\n")
+	}
+	b.WriteString("  esac\n}\n")
+	fmt.Fprintf(&b, "complete -o default -F %s %s\n", fn, shellQuote(command))
+	return b.String(), nil
+}
+
+// A zsh completion script which completes each flag of command with the values of its enum,
+// each described by its desc or display tag. See BashCompletion
+//   script, err := enum.ZshCompletion("pay", map[string]enum.Enummer{"currency": new(CurrencyCodes)})
+//   fmt.Println(script)
+//   // Prints
+//   // #compdef pay
+//   // _arguments \
+//   //   '--currency=[CurrencyCodes]:currency:((USD\:"US Dollar" EUR\:"Euro"))'
+func ZshCompletion(command string, flags map[string]Enummer) (string, error) {
+	fs, err := completionFlags(flags)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "#compdef %s\n_arguments", command)
+	for _, f := range fs {
+		var values []string
+		for _, c := range f.consts {
+			v := zshEscape(string(c.value))
+			if desc := completionDesc(c); desc != "" {
+				v += `\:"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(desc) + `"`
+			}
+			values = append(values, v)
+		}
+		spec := fmt.Sprintf("--%s=[%s]:%s:((%s))", f.name, f.desc.name, f.name, strings.Join(values, " "))
+		fmt.Fprintf(&b, " \\\n  %s", shellQuote(spec))
+	}
+	b.WriteString("\n")
+	return b.String(), nil
+}
+
+// A fish completion script which completes each flag of command with the values of its enum,
+// each described by its desc or display tag. See BashCompletion
+//   script, err := enum.FishCompletion("pay", map[string]enum.Enummer{"currency": new(CurrencyCodes)})
+//   fmt.Println(script)
+//   // Prints
+//   // complete -c 'pay' -l 'currency' -x -a 'USD' -d 'US Dollar'
+//   // complete -c 'pay' -l 'currency' -x -a 'EUR' -d 'Euro'
+func FishCompletion(command string, flags map[string]Enummer) (string, error) {
+	fs, err := completionFlags(flags)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, f := range fs {
+		for _, c := range f.consts {
+			fmt.Fprintf(&b, "complete -c %s -l %s -x -a %s", shellQuote(command), shellQuote(f.name), shellQuote(string(c.value)))
+			if desc := completionDesc(c); desc != "" {
+				fmt.Fprintf(&b, " -d %s", shellQuote(desc))
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String(), nil
+}
+
+// A flag being completed along with the enum it takes.
+type completionFlag struct {
+	name   string
+	desc   *Descriptor
+	consts []constant
+}
+
+// The flags sorted by name, without their leading dashes, each with the Consts of its enum
+// which can be suggested.
+func completionFlags(flags map[string]Enummer) ([]completionFlag, error) {
+	var out []completionFlag
+	for name, e := range flags {
+		d, err := Describe(e)
+		if err != nil {
+			return nil, err
+		}
+		f := completionFlag{name: strings.TrimLeft(name, "-"), desc: d}
+		for _, c := range d.table().consts {
+			if !c.deprecated && !c.retired {
+				f.consts = append(f.consts, c)
+			}
+		}
+		out = append(out, f)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].name < out[j].name
+	})
+	return out, nil
+}
+
+// What a completion shows beside c, its description or else its display name.
+func completionDesc(c constant) string {
+	if c.description != "" {
+		return c.description
+	}
+	return c.display
+}
+
+// Backslash escapes the characters of s which compgen -W would otherwise split or expand.
+func bashEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if !bashSafeRegex.MatchString(string(r)) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Quotes s for the shell so it is read back as a single word.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Escapes the characters zsh treats specially inside a list of values given to _arguments.
+func zshEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `:`, `\:`, ` `, `\ `, `(`, `\(`, `)`, `\)`).Replace(s)
+}
@@ -0,0 +1,15 @@
+package enum
+
+import (
+	"sync/atomic"
+)
+
+var copyOnRead atomic.Bool
+
+// Makes Get return the canonical Const declared on the enum rather than the value that was
+// stored. Values read out of the enum are then never shared with the buffer they were parsed
+// from
+//   enum.SetCopyOnRead(true)
+func SetCopyOnRead(on bool) {
+	copyOnRead.Store(on)
+}
@@ -0,0 +1,20 @@
+package enum
+
+// Implements gocsv's TypeMarshaller, writing the enum's value into the CSV field. Returns an
+// error if the value has been retired
+func (e Enum) MarshalCSV() (string, error) {
+	b, err := e.MarshalText()
+	return string(b), err
+}
+
+// Implements gocsv's TypeUnmarshaller, reading the enum's value from the CSV field. The
+// value is validated straight away if the enum has been constructed, otherwise enum.Validate
+// must be run on each row like after unmarshalling JSON
+//   var rows []*Payment
+//   err := gocsv.Unmarshal(f, &rows)
+//   for _, r := range rows {
+//     err = enum.Validate(&r.CurrencyCode)
+//   }
+func (e *Enum) UnmarshalCSV(s string) error {
+	return e.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,36 @@
+package enum
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Creates an enum called name whose valid values are the first column of the rows returned
+// by query, for reference data such as country lists or product tiers whose source of truth
+// is a table. See LoadFrom
+//   tiers, err := enum.LoadFromDB(ctx, "Tier", db, "SELECT code FROM product_tiers", enum.TTL(time.Hour))
+//   err = tiers.Set("GOLD")
+func LoadFromDB(ctx context.Context, name string, db *sql.DB, query string, opts ...Option) (*RemoteEnum, error) {
+	return LoadFrom(ctx, name, DBSource(db, query), opts...)
+}
+
+// A Source reading values from the first column of the rows returned by query
+//   src := enum.DBSource(db, "SELECT code FROM product_tiers")
+func DBSource(db *sql.DB, query string) Source {
+	return SourceFunc(func(ctx context.Context) ([]Const, error) {
+		rows, err := db.QueryContext(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		var out []Const
+		for rows.Next() {
+			var v string
+			if err := rows.Scan(&v); err != nil {
+				return nil, err
+			}
+			out = append(out, Const(v))
+		}
+		return out, rows.Err()
+	})
+}
@@ -0,0 +1,59 @@
+package enum
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// An error tied to the path of the field that caused it, for example
+//   currency_code: Random is not a valid enum
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (f *FieldError) Error() string {
+	return f.Field + ": " + f.Err.Error()
+}
+
+func (f *FieldError) Unwrap() error {
+	return f.Err
+}
+
+func (f *FieldError) Cause() error {
+	return f.Err
+}
+
+// A json.Decoder that validates every enum in the decoded value, removing the need to call
+// enum.Validate after unmarshalling
+//   var money Money
+//
+//   err := enum.NewDecoder(r).Decode(&money)
+//   fmt.Println(err.Error()) // Prints "currency_code: Random is not a valid enum"
+type Decoder struct {
+	*json.Decoder
+}
+
+// Creates a new Decoder reading from r
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{Decoder: json.NewDecoder(r)}
+}
+
+// Decodes the next JSON value into v then validates every enum reachable from v through
+// ValidateAll, so every invalid enum is reported in one MultiError
+func (d *Decoder) Decode(v interface{}) error {
+	if err := d.Decoder.Decode(v); err != nil {
+		return err
+	}
+	return ValidateAll(v)
+}
+
+// Decodes a single JSON value from r into v and validates every enum within it
+//   var money Money
+//
+//   if err := enum.DecodeStrict(r, &money); err != nil {
+//     return err
+//   }
+func DecodeStrict(r io.Reader, v interface{}) error {
+	return NewDecoder(r).Decode(v)
+}
@@ -0,0 +1,155 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+)
+
+const duplicateEnumErrorMsg = "%s is declared more than once"
+const emptyEnumErrorMsg = "enum values cannot be empty"
+
+// An enum declared from a list of values rather than a struct of Consts. Values are typed
+// as T so each enum gets its own Go type
+//   type CurrencyCode string
+//
+//   const (
+//     USD CurrencyCode = "USD"
+//     EUR CurrencyCode = "EUR"
+//   )
+//
+//   var Currency = enum.Define(USD, EUR)
+type Definition[T ~string] struct {
+	desc *Descriptor
+}
+
+// Declares an enum made up of the provided values. Panics if a value is empty or repeated
+//   var Currency = enum.Define[CurrencyCode]("USD", "EUR", "CAD")
+func Define[T ~string](values ...T) *Definition[T] {
+	d, err := DefineE(values...)
+	if err != nil {
+		panic(err.Error())
+	}
+	return d
+}
+
+// Declares an enum made up of the provided values. Returns an error rather than panicking if
+// a value is empty or repeated
+//   currency, err := enum.DefineE[CurrencyCode]("USD", "EUR", "CAD")
+func DefineE[T ~string](values ...T) (*Definition[T], error) {
+	cs := make([]Const, len(values))
+	for i, v := range values {
+		cs[i] = Const(v)
+	}
+	d, err := newDescriptor(reflect.TypeOf(*new(T)).Name(), cs)
+	if err != nil {
+		return nil, err
+	}
+	return &Definition[T]{desc: d}, nil
+}
+
+// The Descriptor of the enum
+func (d *Definition[T]) Descriptor() *Descriptor {
+	return d.desc
+}
+
+// All values of the enum in declaration order
+func (d *Definition[T]) Values() []T {
+	out := make([]T, len(d.desc.table().consts))
+	for i, c := range d.desc.table().consts {
+		out[i] = T(c.value)
+	}
+	return out
+}
+
+// Whether v is one of the enum's values
+func (d *Definition[T]) Has(v T) bool {
+	return d.desc.has(Const(v))
+}
+
+// Returns an error if v is not one of the enum's values
+func (d *Definition[T]) Validate(v T) error {
+	if !d.Has(v) {
+		return d.desc.invalid(Const(v))
+	}
+	return nil
+}
+
+// Converts s into a value of the enum. Returns an error if s is not one of the enum's values
+func (d *Definition[T]) Parse(s string) (T, error) {
+	if err := d.Validate(T(s)); err != nil {
+		return "", err
+	}
+	return T(s), nil
+}
+
+// Converts s into a value of the enum. Panics if s is not one of the enum's values
+func (d *Definition[T]) MustParse(s string) T {
+	v, err := d.Parse(s)
+	if err != nil {
+		panic(err.Error())
+	}
+	return v
+}
+
+// Unmarshals a JSON string into v, returning an error if it is not one of the enum's values.
+// Lets the value type validate itself during unmarshalling
+//   func (c *CurrencyCode) UnmarshalJSON(b []byte) error {
+//     return Currency.Unmarshal(b, c)
+//   }
+func (d *Definition[T]) Unmarshal(b []byte, v *T) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	p, err := d.Parse(s)
+	if err != nil {
+		return err
+	}
+	*v = p
+	return nil
+}
+
+// Creates an Enum holding v which marshals, validates and is set like any struct based enum
+//   e, err := Currency.New(USD)
+//   err = e.Set(enum.Const(EUR))
+func (d *Definition[T]) New(v T) (*Enum, error) {
+	e := &Enum{desc: d.desc}
+	if v == "" {
+		return e, nil
+	}
+	if err := e.Set(Const(v)); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Builds a Descriptor from a list of values rather than from the fields of a struct.
+func newDescriptor(name string, values []Const) (*Descriptor, error) {
+	consts, err := newConstants(values)
+	if err != nil {
+		return nil, err
+	}
+	d := &Descriptor{name: name}
+	d.tab.Store(newConstTable(name, consts))
+	return d, nil
+}
+
+// The Consts for a list of values, each named after itself. Returns an error if a value is
+// empty or repeated.
+func newConstants(values []Const) ([]constant, error) {
+	consts := make([]constant, 0, len(values))
+	seen := make(map[Const]bool)
+	for _, c := range values {
+		if c == "" {
+			return nil, errors.New(emptyEnumErrorMsg)
+		}
+		if seen[c] {
+			return nil, errors.New(fmt.Sprintf(duplicateEnumErrorMsg, c))
+		}
+		seen[c] = true
+		consts = append(consts, constant{value: c, name: string(c)})
+	}
+	return consts, nil
+}
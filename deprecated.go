@@ -0,0 +1,23 @@
+package enum
+
+const deprecatedWarningMsg = "%s is deprecated: %s"
+
+// A list of the deprecated Consts on the enum. Deprecated Consts are declared with the
+// deprecated tag and, while still valid, are left out of GetAll
+//   type CurrencyCodes struct {
+//     enum.Enum
+//     EUR enum.Const
+//     DEM enum.Const `deprecated:"use EUR"`
+//   }
+func (e *Enum) Deprecated() []Const {
+	var out []Const
+	if e.desc == nil {
+		return out
+	}
+	for _, c := range e.desc.table().consts {
+		if c.deprecated {
+			out = append(out, c.value)
+		}
+	}
+	return out
+}
@@ -0,0 +1,475 @@
+package enum
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var constType = reflect.TypeOf(Const(""))
+var constMarkerType = reflect.TypeOf((*ConstMarker)(nil)).Elem()
+var baseType = reflect.TypeOf(Enum{})
+
+// Enums with at most this many Consts are searched by comparing against each in turn, which
+// beats hashing for the handful of values most enums have. Larger enums use a map.
+const smallEnumSize = 4
+
+const duplicateFieldErrorMsg = "%s is declared by both %s and %s"
+
+// Descriptors are built once per enum type and shared from then on.
+var descriptors sync.Map
+
+type descriptorEntry struct {
+	d   *Descriptor
+	err error
+}
+
+// A read-only description of an enum type. Describing a type only reflects on it once, so
+// tooling can inspect an enum without walking the struct itself
+//   d, _ := enum.Describe(new(CurrencyCodes))
+//   out, _ := json.Marshal(d)
+//   fmt.Println(string(out)) // Prints {"name":"CurrencyCodes","fingerprint":"...","values":[...]}
+type Descriptor struct {
+	name        string
+	fields      []field
+	transitions map[Const][]Const
+	allowed     map[Const]map[Const]struct{}
+	format      jsonFormat
+	xml         xmlLayout
+	defaultVal  Const
+	tab         atomic.Pointer[constTable]
+	extensible  bool
+	ordered     bool
+	loose       bool
+	extendMu    sync.Mutex
+	observers   atomic.Pointer[[]func(old, new Const)]
+	observersMu sync.Mutex
+	// The Descriptor this one was restricted from by Restrict, whose codec it shares
+	restrictedFrom *Descriptor
+}
+
+// The Consts of a Descriptor along with everything derived from them. Tables are never
+// modified once stored, Extend swaps in a new one instead. Extend only ever appends Consts
+// so an ordinal found in one table is valid in every later one, except for enums loaded
+// with LoadFrom whose values are replaced wholesale on Refresh.
+type constTable struct {
+	consts      []constant
+	small       [smallEnumSize]Const
+	byValue     map[Const]int
+	list        []Const
+	fingerprint string
+}
+
+// A read-only description of a single Const on an enum type
+type ConstDescriptor struct {
+	Value       Const             `json:"value"`
+	Name        string            `json:"name"`
+	Ordinal     int               `json:"ordinal"`
+	Display     string            `json:"display,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Aliases     []Const           `json:"aliases,omitempty"`
+	Legacy      []Const           `json:"legacy,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	Transitions []Const           `json:"transitions,omitempty"`
+	Deprecated  string            `json:"deprecated,omitempty"`
+	Retired     string            `json:"retired,omitempty"`
+	Sunset      string            `json:"sunset,omitempty"`
+	Group       string            `json:"group,omitempty"`
+	Parent      Const             `json:"parent,omitempty"`
+}
+
+type constant struct {
+	value       Const
+	name        string
+	display     string
+	description string
+	aliases     []Const
+	legacy      []Const
+	meta        map[string]string
+	deprecated  bool
+	deprecation string
+	retired     bool
+	retirement  string
+	order       int
+	ordered     bool
+	sunset      time.Time
+	code        int
+	coded       bool
+	group       string
+	parent      Const
+	isDefault   bool
+}
+
+// A Const field on the enum struct along with the value construct assigns to it.
+type field struct {
+	index []int
+	value Const
+}
+
+// Gets the Descriptor for the type of the provided enum. Returns an error if the enum
+// is declared incorrectly
+func Describe(e Enummer) (*Descriptor, error) {
+	if isNil(e) {
+		return nil, errors.New(enumNotNilErrorMsg)
+	}
+	if d := e.base().desc; d != nil {
+		return d, nil
+	}
+	return describe(reflect.TypeOf(e).Elem())
+}
+
+// The name of the enum type
+func (d *Descriptor) Name() string {
+	return d.name
+}
+
+// A hash of the enum's name and values which changes whenever the set of values does
+func (d *Descriptor) Fingerprint() string {
+	return d.table().fingerprint
+}
+
+// All Consts on the enum in order, see the order tag
+func (d *Descriptor) Consts() []ConstDescriptor {
+	t := d.table()
+	out := make([]ConstDescriptor, len(t.consts))
+	for i := range t.consts {
+		out[i] = d.describeConst(t, i)
+	}
+	return out
+}
+
+// The values of the Consts GetAll returns as strings, in the same order. Unlike Enum.Values
+// the enum needn't be constructed, which suits integrations handed an enum to list
+//   d, _ := enum.Describe(new(CurrencyCodes))
+//   fmt.Println(d.Values()) // Prints [USD EUR]
+func (d *Descriptor) Values() []string {
+	return Strings(d.listed())
+}
+
+// Gets the description of the provided Const. Returns false if the Const is not on the enum
+func (d *Descriptor) Lookup(c Const) (ConstDescriptor, bool) {
+	t := d.table()
+	if i := t.index(c); i >= 0 {
+		return d.describeConst(t, i), true
+	}
+	return ConstDescriptor{}, false
+}
+
+func (d *Descriptor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name        string            `json:"name"`
+		Fingerprint string            `json:"fingerprint"`
+		Values      []ConstDescriptor `json:"values"`
+	}{
+		Name:        d.name,
+		Fingerprint: d.table().fingerprint,
+		Values:      d.Consts(),
+	})
+}
+
+func (d *Descriptor) describeConst(t *constTable, i int) ConstDescriptor {
+	c := t.consts[i]
+	out := ConstDescriptor{
+		Value:       c.value,
+		Name:        c.name,
+		Ordinal:     i,
+		Display:     c.display,
+		Description: c.description,
+		Deprecated:  c.deprecation,
+		Retired:     c.retirement,
+		Group:       c.group,
+		Parent:      c.parent,
+	}
+	if !c.sunset.IsZero() {
+		out.Sunset = c.sunset.Format(sunsetLayout)
+	}
+	if len(c.aliases) > 0 {
+		out.Aliases = append([]Const(nil), c.aliases...)
+	}
+	if len(c.legacy) > 0 {
+		out.Legacy = append([]Const(nil), c.legacy...)
+	}
+	if len(c.meta) > 0 {
+		out.Metadata = make(map[string]string, len(c.meta))
+		for k, v := range c.meta {
+			out.Metadata[k] = v
+		}
+	}
+	if next, ok := d.transitions[c.value]; ok {
+		out.Transitions = append([]Const{}, next...)
+	}
+	return out
+}
+
+func describe(t reflect.Type) (*Descriptor, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, errors.New(fmt.Sprintf(notEnumErrorMsg, t))
+	}
+	entry, ok := descriptors.Load(t)
+	if !ok {
+		d, err := buildDescriptor(t)
+		entry, _ = descriptors.LoadOrStore(t, descriptorEntry{d: d, err: err})
+	}
+	return entry.(descriptorEntry).d, entry.(descriptorEntry).err
+}
+
+// Builds the Descriptor from t's Const fields. Consts from enum structs embedded in t are
+// included as well, so an enum can be composed from others. Unexported fields and fields
+// tagged enum:"-" are left alone. Returns an error if two of t's own fields, or one of them
+// and an embedded enum, declare the same value
+//   type PaymentCurrencies struct {
+//     enum.Enum
+//     FiatCurrencies
+//     CryptoCurrencies
+//   }
+func buildDescriptor(t reflect.Type) (*Descriptor, error) {
+	d := &Descriptor{name: t.Name()}
+	var consts []constant
+	seen := make(map[Const]string)
+	naming := typeCase(typeTag(t))
+	_, d.extensible = typeTag(t).Lookup("extensible")
+	_, d.ordered = typeTag(t).Lookup("ordered")
+	_, d.loose = typeTag(t).Lookup("loose")
+	format, err := parseFormat(typeTag(t).Get("format"))
+	if err != nil {
+		return nil, err
+	}
+	d.format = format
+	if d.xml, err = parseXMLLayout(typeTag(t).Get("enumxml")); err != nil {
+		return nil, err
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		if f.Anonymous && f.Type != baseType && reflect.PtrTo(f.Type).Implements(enummerType) {
+			sub, err := describe(f.Type)
+			if err != nil {
+				return nil, err
+			}
+			for _, sf := range sub.fields {
+				index := append(append([]int{}, f.Index...), sf.index...)
+				d.fields = append(d.fields, field{index: index, value: sf.value})
+			}
+			for _, c := range sub.table().consts {
+				if _, ok := seen[c.value]; !ok {
+					seen[c.value] = f.Name + "." + c.name
+					consts = append(consts, c)
+				}
+			}
+			continue
+		}
+		if !isConstType(f.Type) || f.Tag.Get("enum") == "-" {
+			continue
+		}
+		s, opts := parseEnumTag(f.Tag.Get("enum"))
+		if s == "" {
+			var ok bool
+			if s, ok = naming.Apply(f.Name); !ok {
+				return nil, errors.New(fmt.Sprintf(unknownCaseErrorMsg, naming))
+			}
+		}
+		c := Const(s)
+		if other, ok := seen[c]; ok {
+			return nil, errors.New(fmt.Sprintf(duplicateFieldErrorMsg, c, other, f.Name))
+		}
+		seen[c] = f.Name
+		d.fields = append(d.fields, field{index: f.Index, value: c})
+		con, err := newConstant(c, f, opts)
+		if err != nil {
+			return nil, err
+		}
+		consts = append(consts, con)
+	}
+	if d.format == intFormat || d.format == protoFormat {
+		if err := numberConsts(d.name, typeTag(t), consts); err != nil {
+			return nil, err
+		}
+	}
+	sortConsts(consts)
+	if err := checkParents(consts); err != nil {
+		return nil, err
+	}
+	if err := checkLegacy(consts); err != nil {
+		return nil, err
+	}
+	if err := checkAliases(consts); err != nil {
+		return nil, err
+	}
+	if err := checkCodes(consts, d.format == intFormat || d.format == protoFormat); err != nil {
+		return nil, err
+	}
+	if d.defaultVal, err = defaultConst(consts); err != nil {
+		return nil, err
+	}
+	d.tab.Store(newConstTable(d.name, consts))
+	if err := d.buildTransitions(t); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Whether fields of type t declare Consts. Besides Const itself this includes named types
+// based on it, such as type CurrencyConst enum.Const, which give each enum a distinct type
+// and opt in by implementing ConstMarker.
+func isConstType(t reflect.Type) bool {
+	return t == constType || (t.Kind() == reflect.String && t.Implements(constMarkerType))
+}
+
+// The tag on the Enum embedded in t, which holds settings for the whole enum.
+func typeTag(t reflect.Type) reflect.StructTag {
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.Anonymous && f.Type == baseType {
+			return f.Tag
+		}
+	}
+	return ""
+}
+
+func newConstant(c Const, f reflect.StructField, opts []tagOption) (constant, error) {
+	out := constant{value: c, name: f.Name, legacy: legacyValues(f)}
+	if tag, ok := f.Tag.Lookup("meta"); ok {
+		meta, err := parseMeta(f.Name, tag)
+		if err != nil {
+			return out, err
+		}
+		out.meta = meta
+	}
+	var all []tagOption
+	for _, key := range optionTags {
+		if tag, ok := f.Tag.Lookup(key); ok {
+			all = append(all, tagOption{key: key, value: tag})
+		}
+	}
+	for _, o := range append(all, opts...) {
+		if err := out.apply(f.Name, o); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+// The Consts GetAll returns, which leaves out deprecated and retired Consts. The slice is
+// shared so it must not be modified.
+func (d *Descriptor) listed() []Const {
+	return d.table().list
+}
+
+// The current table of Consts.
+func (d *Descriptor) table() *constTable {
+	return d.tab.Load()
+}
+
+func (d *Descriptor) has(c Const) bool {
+	return d.index(c) >= 0
+}
+
+// The Const as declared on the enum, which c is either the value, an alias or a legacy value
+// of, or c itself if it isn't declared.
+func (d *Descriptor) canonical(c Const) Const {
+	if d == nil {
+		return c
+	}
+	return d.table().canonical(c)
+}
+
+// The Const declared with the value c, nil if there is none. The table is only loaded once
+// so the result holds together even if RemoteEnum.Refresh swaps in a new one meanwhile.
+func (d *Descriptor) lookup(c Const) *constant {
+	return d.table().lookup(c)
+}
+
+// See Descriptor.canonical.
+func (t *constTable) canonical(c Const) Const {
+	if i := t.index(c); i >= 0 {
+		return t.consts[i].value
+	}
+	for _, con := range t.consts {
+		for _, a := range con.aliases {
+			if a == c {
+				return con.value
+			}
+		}
+		for _, l := range con.legacy {
+			if l == c {
+				return con.value
+			}
+		}
+	}
+	return c
+}
+
+// The ordinal of c or -1 if c is not on the enum.
+func (d *Descriptor) index(c Const) int {
+	return d.table().index(c)
+}
+
+// See Descriptor.lookup.
+func (t *constTable) lookup(c Const) *constant {
+	if i := t.index(c); i >= 0 {
+		return &t.consts[i]
+	}
+	return nil
+}
+
+func (t *constTable) index(c Const) int {
+	if t.byValue != nil {
+		if i, ok := t.byValue[c]; ok {
+			return i
+		}
+		return -1
+	}
+	n := len(t.consts)
+	switch {
+	case n > 0 && t.small[0] == c:
+		return 0
+	case n > 1 && t.small[1] == c:
+		return 1
+	case n > 2 && t.small[2] == c:
+		return 2
+	case n > 3 && t.small[3] == c:
+		return 3
+	}
+	return -1
+}
+
+// Indexes the Consts by value and caches the list GetAll returns and the fingerprint of the
+// enum called name.
+func newConstTable(name string, consts []constant) *constTable {
+	t := &constTable{consts: consts}
+	for _, c := range consts {
+		if !c.deprecated && !c.retired {
+			t.list = append(t.list, c.value)
+		}
+	}
+	t.list = t.list[:len(t.list):len(t.list)]
+	if len(consts) <= smallEnumSize {
+		for i, c := range consts {
+			t.small[i] = c.value
+		}
+	} else {
+		t.byValue = make(map[Const]int, len(consts))
+		for i, c := range consts {
+			t.byValue[c.value] = i
+		}
+	}
+	t.fingerprint = fingerprint(name, consts)
+	return t
+}
+
+func fingerprint(name string, consts []constant) string {
+	h := sha256.New()
+	h.Write([]byte(name))
+	for _, c := range consts {
+		h.Write([]byte{0})
+		h.Write([]byte(c.value))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
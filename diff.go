@@ -0,0 +1,104 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The differences between two versions of an enum, see Diff
+type ChangeSet struct {
+	Added   []Const  `json:"added,omitempty"`
+	Removed []Const  `json:"removed,omitempty"`
+	Renamed []Rename `json:"renamed,omitempty"`
+}
+
+// A Const whose struct field kept its name while its value changed
+type Rename struct {
+	Name string `json:"name"`
+	From Const  `json:"from"`
+	To   Const  `json:"to"`
+}
+
+// Compares two versions of an enum, listing the Consts which were added, removed or renamed.
+// A Const is renamed when the struct field declaring it is kept but its value changes.
+// Useful in CI or migration tooling to catch values being dropped while they are still
+// stored somewhere. Panics if either enum is declared incorrectly
+//   changes := enum.Diff(new(v1.CurrencyCodes), new(v2.CurrencyCodes))
+//   if changes.Breaking() {
+//     log.Fatalf("stored currency codes would no longer be valid: %s", changes)
+//   }
+func Diff(old, new Enummer) ChangeSet {
+	out, err := DiffE(old, new)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// Compares two versions of an enum, see Diff. Returns an error rather than panicking if
+// either enum is nil or declared incorrectly
+func DiffE(old, new Enummer) (ChangeSet, error) {
+	var out ChangeSet
+	from, err := Describe(old)
+	if err != nil {
+		return out, err
+	}
+	to, err := Describe(new)
+	if err != nil {
+		return out, err
+	}
+	before, after := from.table(), to.table()
+
+	renamed := make(map[Const]bool)
+	for _, c := range before.consts {
+		if after.index(c.value) >= 0 {
+			continue
+		}
+		if n, ok := after.byName(c.name); ok && before.index(n.value) < 0 {
+			out.Renamed = append(out.Renamed, Rename{Name: c.name, From: c.value, To: n.value})
+			renamed[n.value] = true
+			continue
+		}
+		out.Removed = append(out.Removed, c.value)
+	}
+	for _, c := range after.consts {
+		if before.index(c.value) < 0 && !renamed[c.value] {
+			out.Added = append(out.Added, c.value)
+		}
+	}
+	return out, nil
+}
+
+// Whether the enums are the same
+func (c ChangeSet) Empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Renamed) == 0
+}
+
+// Whether any value valid on the old enum is no longer valid on the new one
+func (c ChangeSet) Breaking() bool {
+	return len(c.Removed) > 0 || len(c.Renamed) > 0
+}
+
+func (c ChangeSet) String() string {
+	var parts []string
+	for _, v := range c.Added {
+		parts = append(parts, fmt.Sprintf("+%s", v))
+	}
+	for _, v := range c.Removed {
+		parts = append(parts, fmt.Sprintf("-%s", v))
+	}
+	for _, r := range c.Renamed {
+		parts = append(parts, fmt.Sprintf("%s: %s -> %s", r.Name, r.From, r.To))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// The Const declared by the struct field called name.
+func (t *constTable) byName(name string) (constant, bool) {
+	for _, c := range t.consts {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return constant{}, false
+}
@@ -0,0 +1,54 @@
+package enum
+
+// The presentation string for the enum's current value, taken from the value's display tag.
+// Falls back to the value itself when there is no tag, the marshalled value is unaffected
+//   type CurrencyCodes struct {
+//     enum.Enum
+//     USD enum.Const `display:"US Dollar"`
+//   }
+//
+//   cc := enum.MustConstruct(new(CurrencyCodes), enum.Const("USD"))
+//   fmt.Println(cc.DisplayName()) // Prints "US Dollar"
+func (e *Enum) DisplayName() string {
+	if e.desc != nil {
+		return e.desc.displayName(e.val)
+	}
+	return string(e.val)
+}
+
+// The presentation string of every Const which can be set on the enum
+func (e *Enum) DisplayNames() map[Const]string {
+	out := make(map[Const]string)
+	if e.desc == nil {
+		return out
+	}
+	for _, c := range e.desc.table().consts {
+		if !c.retired {
+			out[c.value] = e.desc.displayName(c.value)
+		}
+	}
+	return out
+}
+
+// The longer explanation of c declared with its desc tag, empty if it has none or isn't on the
+// enum
+//   type CurrencyCodes struct {
+//     enum.Enum
+//     XAU enum.Const `display:"Gold" desc:"One troy ounce of gold"`
+//   }
+func (e *Enum) Description(c Const) string {
+	if e.desc == nil {
+		return ""
+	}
+	if k := e.desc.lookup(c); k != nil {
+		return k.description
+	}
+	return ""
+}
+
+func (d *Descriptor) displayName(c Const) string {
+	if k := d.lookup(c); k != nil && k.display != "" {
+		return k.display
+	}
+	return string(c)
+}
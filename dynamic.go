@@ -0,0 +1,19 @@
+package enum
+
+// Creates an enum entirely at runtime from a list of values, for when the valid values come
+// from config or an admin API rather than from a struct declaration. The enum starts out
+// empty and behaves like any struct based enum. Returns an error if a value is empty or
+// repeated
+//   regions, err := enum.FromValues("Region", cfg.Regions)
+//   err = regions.Set("eu-west-1")
+func FromValues(name string, values []string) (Enummer, error) {
+	cs := make([]Const, len(values))
+	for i, v := range values {
+		cs[i] = Const(v)
+	}
+	d, err := newDescriptor(name, cs)
+	if err != nil {
+		return nil, err
+	}
+	return &Enum{desc: d}, nil
+}
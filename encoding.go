@@ -0,0 +1,87 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+const unknownFormatErrorMsg = "%q is not a valid format, expected int, object, proto or string"
+const objectValueErrorMsg = "enum object for %s is missing its value"
+
+// How an enum is encoded in JSON, chosen with the format tag on its Enum.
+type jsonFormat int
+
+const (
+	stringFormat jsonFormat = iota
+	intFormat
+	objectFormat
+	protoFormat
+)
+
+func parseFormat(tag string) (jsonFormat, error) {
+	switch tag {
+	case "", "string":
+		return stringFormat, nil
+	case "int":
+		return intFormat, nil
+	case "object":
+		return objectFormat, nil
+	case "proto":
+		return protoFormat, nil
+	}
+	return stringFormat, errors.New(fmt.Sprintf(unknownFormatErrorMsg, tag))
+}
+
+// The JSON form of an enum tagged format:"object". The label is the value's display name
+//   type CurrencyCodes struct {
+//     enum.Enum `format:"object"`
+//     USD enum.Const `display:"US Dollar"`
+//   }
+//
+//   out, _ := json.Marshal(cc)
+//   fmt.Println(string(out)) // Prints {"value":"USD","label":"US Dollar"}
+type jsonObject struct {
+	Value *Const `json:"value"`
+	Label string `json:"label,omitempty"`
+}
+
+// Marshals the value through the enum's Codec or else in the format the enum asks for.
+func (e Enum) marshalFormatted() ([]byte, error) {
+	if c, ok := e.desc.codec(); ok {
+		return e.marshalCodec(c)
+	}
+	if e.desc != nil {
+		switch e.desc.format {
+		case intFormat:
+			return e.marshalCode()
+		case objectFormat:
+			return e.marshalObject()
+		case protoFormat:
+			return e.marshalProto()
+		}
+	}
+	return []byte(strconv.Quote(string(e.val))), nil
+}
+
+// Marshals the value along with its display name, or null if it has no value.
+func (e Enum) marshalObject() ([]byte, error) {
+	if e.val == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(jsonObject{Value: &e.val, Label: e.desc.displayName(e.val)})
+}
+
+// Unmarshals an object written by marshalObject, ignoring its label.
+func (e *Enum) unmarshalObject(b []byte) error {
+	var o jsonObject
+	if err := json.Unmarshal(b, &o); err != nil {
+		return err
+	}
+	if o.Value == nil {
+		return errors.New(fmt.Sprintf(objectValueErrorMsg, e.desc.name))
+	}
+	e.unsafeSet(e.desc.canonical(*o.Value))
+	return nil
+}
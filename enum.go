@@ -13,15 +13,19 @@
 package enum
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/pkg/errors"
 	"reflect"
-	"strconv"
+	"unicode/utf8"
 )
 
 const invalidEnumErrorMsg = "%s is not a valid enum"
 const enumNotConstructedErrorMsg = "cannot set a value on an enum that has not be constructed"
 const enumNotNilErrorMsg = "cannot set a value on an enum that has not be constructed"
+const retiredEnumErrorMsg = "%s has been retired"
+const suggestionErrorMsg = "%s is not a valid enum, did you mean %s?"
+const invalidUTF8ErrorMsg = "enum value is not valid UTF-8"
 
 type Enummer interface {
 	Get() Const
@@ -31,15 +35,15 @@ type Enummer interface {
 	// Sets the value without any checks. This is a dangerous method and should pretty
 	// much never be used but if you do, USE WITH CAUTION.
 	unsafeSet(c Const)
-	// Adds an additional valid value. This is a dangerous method and should pretty
-	// much never be used but if you do, USE WITH CAUTION.
-	unsafeAdd(c Const)
+	// The embedded Enum which holds the enum's state.
+	base() *Enum
 }
 
-// The base type for all Enums. Stores the current value and keeps track of all valid values.
+// The base type for all Enums. Stores the current value along with the Descriptor of the
+// enum's type, which keeps track of all valid values and is shared by every enum of the type.
 type Enum struct {
 	val  Const
-	vals []Const
+	desc *Descriptor
 }
 
 // The base value for all Enum fields. The name of the field on the enum struct will be
@@ -53,27 +57,116 @@ type Enum struct {
 // The value of the USD const is now "not usd"
 type Const string
 
-func (e *Enum) unsafeAdd(c Const) {
-	if !contains(e.vals, c) {
-		e.vals = append(e.vals, c)
+// A list of all possible Consts on the enum in the order they are declared, unless the order
+// tag says otherwise. Deprecated Consts are left out, see Deprecated. The slice is a copy so
+// it can be modified freely, use Count or Has to avoid copying
+func (e *Enum) GetAll() []Const {
+	all := e.all()
+	if all == nil {
+		return nil
 	}
+	return append(make([]Const, 0, len(all)), all...)
 }
 
-// A list of all possible Consts on the enum
-func (e *Enum) GetAll() []Const {
-	return e.vals
+// The number of Consts GetAll returns, without copying them
+func (e *Enum) Count() int {
+	return len(e.all())
+}
+
+// Whether c is one of the Consts GetAll returns, without scanning them. Like GetAll, this
+// leaves out deprecated Consts even though they can still be set
+func (e *Enum) Has(c Const) bool {
+	if e.desc == nil {
+		return false
+	}
+	k := e.desc.lookup(c)
+	return k != nil && !k.deprecated && !k.retired
+}
+
+// The values of the Consts GetAll returns as strings, in the same order
+func (e *Enum) Values() []string {
+	all := e.all()
+	out := make([]string, len(all))
+	for i, c := range all {
+		out[i] = string(c)
+	}
+	return out
+}
+
+// The names of the struct fields declaring the Consts GetAll returns, in the same order
+//   type CurrencyCodes struct {
+//     enum.Enum
+//     Custom enum.Const `enum:"CUSTOM"`
+//   }
+//
+//   fmt.Println(cc.Names()) // Prints [Custom]
+func (e *Enum) Names() []string {
+	if e.desc == nil {
+		return []string{}
+	}
+	t := e.desc.table()
+	out := make([]string, len(t.list))
+	for i, c := range t.list {
+		out[i] = t.lookup(c).name
+	}
+	return out
+}
+
+// Gets the Const declared by the struct field called name. Returns false if there is no
+// such field or its Const has been retired
+//   c, ok := cc.ByName("Custom")
+//   fmt.Println(c) // Prints "CUSTOM"
+func (e *Enum) ByName(name string) (Const, bool) {
+	if e.desc == nil {
+		return "", false
+	}
+	for _, c := range e.desc.table().consts {
+		if c.name == name && !c.retired {
+			return c.value, true
+		}
+	}
+	return "", false
 }
 
 func (e *Enum) unsafeSet(c Const) {
 	e.val = c
 }
 
+func (e *Enum) base() *Enum {
+	return e
+}
+
+// The Consts GetAll returns, nil if the enum hasn't been constructed.
+func (e *Enum) all() []Const {
+	if e.desc == nil {
+		return nil
+	}
+	return e.desc.listed()
+}
+
+func (e *Enum) constructed() bool {
+	return e.desc != nil
+}
+
+// Whether c can be stored on the enum. Unlike GetAll this includes deprecated Consts.
+func (e *Enum) valid(c Const) bool {
+	if e.desc == nil {
+		return false
+	}
+	k := e.desc.lookup(c)
+	return k != nil && !k.retired
+}
+
 func (e Enum) String() string {
 	return string(e.Get())
 }
 
-// Unmarshalls the string into an Enum. NOTE: you must run enum.Validate
-// after unmarshalling a string like so
+// Unmarshalls the string into an Enum. Anything other than a JSON string containing valid
+// UTF-8 is rejected, except for null which leaves the enum untouched and, for constructed
+// enums, the encoding their format tag asks for or, if their Enum is tagged loose, a number
+// or loosely matching string as well. A number or object unmarshalled before the enum is
+// constructed is kept as is, and read as a code or object once it is. NOTE: you must run
+// enum.Validate after unmarshalling a string like so
 //   func main() {
 //     var money Money
 //
@@ -83,29 +176,80 @@ func (e Enum) String() string {
 //     fmt.Println(money) // Prints "{USD 5}"
 //   }
 func (e *Enum) UnmarshalJSON(b []byte) error {
-	s, _ := strconv.Unquote(string(b))
-	c := Const(s)
+	if string(b) == "null" {
+		return nil
+	}
+	if !utf8.Valid(b) {
+		return errors.New(invalidUTF8ErrorMsg)
+	}
+	if e.desc == nil && len(b) > 0 && (b[0] == '-' || b[0] == '{' || (b[0] >= '0' && b[0] <= '9')) {
+		e.unsafeSet(Const(b))
+		return nil
+	}
+	if c, ok := e.desc.codec(); ok {
+		return e.unmarshalCodec(c, b)
+	}
+	if e.desc != nil && e.desc.loose {
+		return e.unmarshalLoose(b)
+	}
+	if e.desc != nil && (e.desc.format == intFormat || e.desc.format == protoFormat) && len(b) > 0 && (b[0] == '-' || (b[0] >= '0' && b[0] <= '9')) {
+		return e.unmarshalCode(b)
+	}
+	if e.desc != nil && e.desc.format == objectFormat && len(b) > 0 && b[0] == '{' {
+		return e.unmarshalObject(b)
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	c := e.desc.canonical(Const(s))
 	e.unsafeSet(c)
 	return nil
 }
 
+// Marshals the enum's value into a JSON string, or into the encoding the enum's format tag
+// asks for. Returns an error if the value has been retired
 func (e Enum) MarshalJSON() ([]byte, error) {
-	return []byte(strconv.Quote(string(e.val))), nil
+	if e.desc != nil && e.desc.retired(e.val) {
+		return nil, errors.New(fmt.Sprintf(retiredMarshalErrorMsg, e.val))
+	}
+	return e.marshalFormatted()
 }
 
 // Gets the value stored on the enum
 func (e *Enum) Get() Const {
+	if copyOnRead.Load() && e.desc != nil {
+		return e.desc.canonical(e.val)
+	}
 	return e.val
 }
 
-// Set the value stored on the enum. Returns an error if value is invalid
+// Set the value stored on the enum. Returns an error if value is invalid or, for enums with
+// transitions, if the current value cannot transition to it. Setting a deprecated value
+// succeeds but logs a warning if a Logger has been provided through SetLogger, as does
+// setting a value past its sunset date unless SetSunsetPolicy says otherwise. An alias or
+// legacy value is stored as the Const it stands for. Observers registered through OnChange
+// are called once the value is stored
 func (e *Enum) Set(c Const) error {
-	if e.vals != nil {
-		if contains(e.GetAll(), c) {
+	if e.desc != nil {
+		t := e.desc.table()
+		c = t.canonical(c)
+		if k := t.lookup(c); k != nil && !k.retired {
+			if err := e.desc.checkTransition(e.val, c); err != nil {
+				return err
+			}
+			if err := k.checkSunset(false); err != nil {
+				return err
+			}
+			if k.deprecated {
+				logf(deprecatedWarningMsg, c, k.deprecation)
+			}
+			old := e.val
 			e.val = c
+			e.notify(old, c)
 			return nil
 		} else {
-			return errors.New(fmt.Sprintf(invalidEnumErrorMsg, c))
+			return t.invalid(e.desc.name, c)
 		}
 	} else {
 		return errors.New(enumNotConstructedErrorMsg)
@@ -120,11 +264,40 @@ func (e *Enum) MustSet(s Const) {
 	}
 }
 
-// Creates a new Enummer with no value set
+// Creates a new Enummer holding the Const marked default, or no value if there isn't one.
+// Panics if the enum is declared incorrectly
 //   cc := enum.New(new(CurrencyCodes)).(*CurrencyCodes)
 func New(e Enummer) Enummer {
-	construct(e)
-	return e
+	out, err := NewE(e)
+	if err != nil {
+		panic(err.Error())
+	}
+	return out
+}
+
+// Creates a new Enummer holding the Const marked default, or no value if there isn't one.
+// Returns an error rather than panicking if e is nil or declared incorrectly
+//   cc, err := enum.NewE(new(CurrencyCodes))
+func NewE(e Enummer) (Enummer, error) {
+	if err := construct(e); err != nil {
+		return nil, err
+	}
+	if c := e.base().desc.defaultVal; c != "" {
+		e.unsafeSet(c)
+	}
+	return e, nil
+}
+
+// The Const marked default in its enum tag. Returns false if there is none
+//   type CurrencyCodes struct {
+//     enum.Enum
+//     USD enum.Const `enum:"USD,default"`
+//   }
+func (e *Enum) Default() (Const, bool) {
+	if e.desc == nil || e.desc.defaultVal == "" {
+		return "", false
+	}
+	return e.desc.defaultVal, true
 }
 
 // Instantiates an Enum with the provided value. If the value is invalid, an error is returned
@@ -135,10 +308,9 @@ func New(e Enummer) Enummer {
 //   }
 //   cc = cc.(*CurrencyCodes)
 func Construct(e Enummer, c Const) (Enummer, error) {
-	if e == nil {
-		return nil, errors.New(enumNotNilErrorMsg)
+	if err := construct(e); err != nil {
+		return nil, err
 	}
-	construct(e)
 	if err := e.Set(c); err != nil {
 		return nil, err
 	}
@@ -157,6 +329,8 @@ func MustConstruct(e Enummer, c Const) Enummer {
 }
 
 // Instantiates the enum if that hasn't been done and validates that its current value is valid.
+// Retired values, and values past their sunset date under SunsetReject, are rejected unless
+// the Lenient option is provided.
 // Commonly used after unmarshalling an enum like so
 //   func main() {
 //     var money Money
@@ -166,13 +340,38 @@ func MustConstruct(e Enummer, c Const) Enummer {
 //
 //     fmt.Println(money) // Prints "{USD 5}"
 //   }
-func Validate(e Enummer) error {
-	if e.GetAll() == nil {
-		construct(e)
+func Validate(e Enummer, opts ...Option) error {
+	o := newOptions(opts)
+	if err := ensureConstructed(e); err != nil {
+		return err
 	}
 
-	if !contains(e.GetAll(), e.Get()) {
-		return errors.New(fmt.Sprintf(invalidEnumErrorMsg, e.Get()))
+	d := e.base().desc
+	var t *constTable
+	var k *constant
+	if d != nil {
+		t = d.table()
+		if c := t.canonical(e.base().val); c != e.base().val {
+			e.unsafeSet(c)
+		}
+		k = t.lookup(e.Get())
+	}
+	if k != nil && k.retired && o.lenient {
+		logf(retiredWarningMsg, e.Get(), k.retirement)
+		return nil
+	}
+
+	if k != nil {
+		if err := k.checkSunset(o.lenient); err != nil {
+			return err
+		}
+	}
+
+	if k == nil || k.retired {
+		if d != nil {
+			return t.invalid(d.name, e.Get())
+		}
+		return &InvalidEnumError{Value: e.Get()}
 	}
 
 	return nil
@@ -187,19 +386,38 @@ func contains(cs []Const, c Const) bool {
 	return false
 }
 
-func construct(e Enummer) {
+func ensureConstructed(e Enummer) error {
+	if e.base().constructed() {
+		return nil
+	}
+	return construct(e)
+}
+
+func construct(e Enummer) error {
+	if isNil(e) {
+		return errors.New(enumNotNilErrorMsg)
+	}
 	v := reflect.ValueOf(e).Elem()
-	for i := 0; i < v.NumField(); i++ {
-		t := v.Type().Field(i)
-		f := v.Field(i)
-		if _, ok := f.Interface().(Const); ok {
-			s := t.Tag.Get("enum")
-			if s == "" {
-				s = t.Name
-			}
-			c := Const(s)
-			e.unsafeAdd(c)
-			f.Set(reflect.ValueOf(c))
-		}
+	d, err := describe(v.Type())
+	if err != nil {
+		return err
+	}
+	for _, f := range d.fields {
+		v.FieldByIndex(f.index).SetString(string(f.value))
+	}
+	e.base().desc = d
+	if err := e.base().decodePending(); err != nil {
+		return err
+	}
+	e.base().val = d.canonical(e.base().val)
+	return nil
+}
+
+// Whether e is nil or a nil pointer.
+func isNil(e Enummer) bool {
+	if e == nil {
+		return true
 	}
+	v := reflect.ValueOf(e)
+	return v.Kind() == reflect.Ptr && v.IsNil()
 }
@@ -0,0 +1,67 @@
+// Integrates go-enum with github.com/hamba/avro/v2, encoding enums against the schema from
+// enum.AvroSchema
+//   b, err := enumavro.Marshal(cc)
+//   err = enumavro.Unmarshal(cc, b)
+package enumavro
+
+import (
+	"encoding/json"
+	"github.com/hamba/avro/v2"
+	"go-enum"
+	"strconv"
+	"sync"
+)
+
+// Parsed schemas keyed by the *enum.Descriptor they were parsed for.
+var schemas sync.Map
+
+// Parses the Avro schema of the enum. Returns an error if a Const isn't a valid Avro
+// symbol, which must match [A-Za-z_][A-Za-z0-9_]*
+func Schema(e enum.Enummer) (avro.Schema, error) {
+	d, err := enum.Describe(e)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := schemas.Load(d); ok {
+		return s.(avro.Schema), nil
+	}
+	schema, err := enum.AvroSchemaE(e)
+	if err != nil {
+		return nil, err
+	}
+	s, err := avro.Parse(schema)
+	if err != nil {
+		return nil, err
+	}
+	schemas.Store(d, s)
+	return s, nil
+}
+
+// Encodes the enum's value as an Avro enum. Returns an error if the value is invalid or retired
+func Marshal(e enum.Enummer) ([]byte, error) {
+	s, err := Schema(e)
+	if err != nil {
+		return nil, err
+	}
+	if err := enum.Validate(e); err != nil {
+		return nil, err
+	}
+	return avro.Marshal(s, string(e.Get()))
+}
+
+// Decodes an Avro enum into the enum. Retired values are accepted so data written before a
+// value was retired can still be read
+func Unmarshal(e enum.Enummer, data []byte) error {
+	s, err := Schema(e)
+	if err != nil {
+		return err
+	}
+	var v string
+	if err := avro.Unmarshal(s, data, &v); err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(strconv.Quote(v)), e); err != nil {
+		return err
+	}
+	return enum.Validate(e, enum.Lenient())
+}
@@ -0,0 +1,20 @@
+// Integrates go-enum with github.com/go-chi/chi, reading enums from URL parameters
+//   r.Get("/orders/{status}", func(w http.ResponseWriter, r *http.Request) {
+//     status := enum.New(new(OrderStatus)).(*OrderStatus)
+//     if err := enumchi.FromRequestPath(status, r, "status"); err != nil {
+//       http.Error(w, err.Error(), http.StatusBadRequest)
+//       return
+//     }
+//   })
+package enumchi
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go-enum"
+	"net/http"
+)
+
+// Sets the enum from the chi URL parameter param of r, see enum.FromRequestPath
+func FromRequestPath(e enum.Enummer, r *http.Request, param string, opts ...enum.Option) error {
+	return enum.FromParam(e, param, chi.URLParam(r, param), opts...)
+}
@@ -0,0 +1,38 @@
+// Integrates go-enum with github.com/urfave/cli/v2 so enum flags are validated as they are
+// parsed and list their values in help output
+//   cc := enum.New(new(CurrencyCodes)).(*CurrencyCodes)
+//   app := &cli.App{
+//     Flags: []cli.Flag{enumcli.EnumFlag("currency", "the currency to charge in", cc)},
+//   }
+package enumcli
+
+import (
+	"github.com/urfave/cli/v2"
+	"go-enum"
+	"strings"
+)
+
+// Adapts the enum to cli.Generic, see enum.Flag
+func Generic(e enum.Enummer) cli.Generic {
+	return enum.Flag(e)
+}
+
+// Builds a flag called name which sets its value on e. The usage is followed by the values
+// the flag accepts, leaving out deprecated and retired Consts
+//   enumcli.EnumFlag("currency", "the currency to charge in", cc) // --currency value  the currency to charge in (one of USD, EUR)
+func EnumFlag(name, usage string, e enum.Enummer) *cli.GenericFlag {
+	return &cli.GenericFlag{
+		Name:  name,
+		Usage: usage + " (one of " + strings.Join(values(e), ", ") + ")",
+		Value: Generic(e),
+	}
+}
+
+// The Consts which can be suggested for e.
+func values(e enum.Enummer) []string {
+	d, err := enum.Describe(e)
+	if err != nil {
+		return nil
+	}
+	return d.Values()
+}
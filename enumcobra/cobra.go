@@ -0,0 +1,29 @@
+// Integrates go-enum with github.com/spf13/cobra
+//   cc := enum.New(new(CurrencyCodes)).(*CurrencyCodes)
+//   cmd.Flags().Var(enum.Flag(cc), "currency", "the currency to charge in")
+//   cmd.RegisterFlagCompletionFunc("currency", enumcobra.CompletionFunc(cc))
+package enumcobra
+
+import (
+	"github.com/spf13/cobra"
+	"go-enum"
+	"strings"
+)
+
+// Returns a shell completion function which suggests the enum's Consts that start with
+// what has been typed so far. Deprecated and retired Consts are never suggested
+func CompletionFunc(e enum.Enummer) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		d, err := enum.Describe(e)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		var out []string
+		for _, v := range d.Values() {
+			if strings.HasPrefix(v, toComplete) {
+				out = append(out, v)
+			}
+		}
+		return out, cobra.ShellCompDirectiveNoFileComp
+	}
+}
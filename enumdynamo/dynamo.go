@@ -0,0 +1,50 @@
+// Integrates go-enum with github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue. The
+// attributevalue Marshaler and Unmarshaler interfaces take DynamoDB types which Enum can't
+// depend on, so enums implement them by delegating to this package
+//   func (c CurrencyCodes) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+//     return enumdynamo.Marshal(&c)
+//   }
+//
+//   func (c *CurrencyCodes) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+//     return enumdynamo.Unmarshal(c, av)
+//   }
+package enumdynamo
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/pkg/errors"
+	"go-enum"
+	"strconv"
+)
+
+const unsupportedAttributeErrorMsg = "cannot unmarshal %T into an enum, expected a string"
+
+// Converts the enum into a string attribute, or a NULL attribute if it has no value. Returns
+// an error if the value is invalid or retired
+func Marshal(e enum.Enummer) (types.AttributeValue, error) {
+	if e.Get() == "" {
+		return &types.AttributeValueMemberNULL{Value: true}, nil
+	}
+	if err := enum.Validate(e); err != nil {
+		return nil, err
+	}
+	return &types.AttributeValueMemberS{Value: string(e.Get())}, nil
+}
+
+// Sets the enum from a string attribute and validates it. Retired values are accepted so
+// items written before a value was retired can still be loaded. A NULL attribute leaves
+// the enum untouched
+func Unmarshal(e enum.Enummer, av types.AttributeValue) error {
+	switch v := av.(type) {
+	case *types.AttributeValueMemberNULL:
+		return nil
+	case *types.AttributeValueMemberS:
+		if err := json.Unmarshal([]byte(strconv.Quote(v.Value)), e); err != nil {
+			return err
+		}
+		return enum.Validate(e, enum.Lenient())
+	}
+	return errors.New(fmt.Sprintf(unsupportedAttributeErrorMsg, av))
+}
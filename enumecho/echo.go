@@ -0,0 +1,62 @@
+// Integrates go-enum with github.com/labstack/echo so enums in bound requests are validated.
+// Enums are read from JSON bodies as well as query, form and path parameters
+//   e.Validator = enumecho.NewValidator(nil)
+//
+//   var req ListOrders
+//   if err := enumecho.Bind(c, &req); err != nil {
+//     return err // <-- a 400 listing the invalid fields
+//   }
+package enumecho
+
+import (
+	"github.com/labstack/echo/v4"
+	"go-enum"
+	"net/http"
+)
+
+// The message of the *echo.HTTPError returned for a request that can't be bound, see
+// enum.ErrorResponse
+type ErrorResponse = enum.ErrorResponse
+
+// A problem with a single field of a request
+type FieldError = enum.ErrorDetail
+
+type validator struct {
+	next echo.Validator
+}
+
+// Returns an echo.Validator which validates every enum in the value with enum.ValidateAll
+// before handing it to next, if next isn't nil. Invalid enums are reported as a 400
+// *echo.HTTPError holding an ErrorResponse
+func NewValidator(next echo.Validator) echo.Validator {
+	return &validator{next: next}
+}
+
+func (v *validator) Validate(i interface{}) error {
+	if err := enum.ValidateAll(i); err != nil {
+		return badRequest(err)
+	}
+	if v.next == nil {
+		return nil
+	}
+	return v.next.Validate(i)
+}
+
+// Binds the request into i like c.Bind, then validates every enum in i. Returns a 400
+// *echo.HTTPError holding an ErrorResponse if either fails
+func Bind(c echo.Context, i interface{}) error {
+	if err := c.Bind(i); err != nil {
+		if he, ok := err.(*echo.HTTPError); ok && he.Internal != nil {
+			err = he.Internal
+		}
+		return badRequest(err)
+	}
+	if err := enum.ValidateAll(i); err != nil {
+		return badRequest(err)
+	}
+	return nil
+}
+
+func badRequest(err error) *echo.HTTPError {
+	return echo.NewHTTPError(http.StatusBadRequest, enum.NewErrorResponse(err)).SetInternal(err)
+}
@@ -0,0 +1,24 @@
+// Integrates go-enum with github.com/kelseyhightower/envconfig so enums in env driven config
+// structs are parsed and validated. envconfig reads enums through UnmarshalText, which can't
+// validate an enum that hasn't been constructed, and enum.Enum can't implement
+// envconfig.Setter since its Set takes a Const. Process validates afterwards instead
+//   type Config struct {
+//     Currency CurrencyCodes `envconfig:"CURRENCY" default:"USD"`
+//   }
+//
+//   err := enumenvconfig.Process("app", &cfg) // <-- reports APP_CURRENCY=Random as invalid
+package enumenvconfig
+
+import (
+	"github.com/kelseyhightower/envconfig"
+	"go-enum"
+)
+
+// Populates spec from the environment with envconfig.Process, then prepares and checks every
+// enum in spec with enum.ValidateConfig, giving unset enums their default Const
+func Process(prefix string, spec interface{}, opts ...enum.Option) error {
+	if err := envconfig.Process(prefix, spec); err != nil {
+		return err
+	}
+	return enum.ValidateConfig(spec, opts...)
+}
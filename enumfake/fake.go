@@ -0,0 +1,50 @@
+// Integrates go-enum with github.com/brianvoe/gofakeit
+//   f := gofakeit.New(0)
+//   var money Money
+//   err := enumfake.Struct(f, &money)
+package enumfake
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"go-enum"
+	"math/rand"
+)
+
+// Fills v through f.Struct then sets every enum within it to a random valid Const drawn
+// from f
+func Struct(f *gofakeit.Faker, v interface{}) error {
+	if err := f.Struct(v); err != nil {
+		return err
+	}
+	return enum.FakeFillRand(v, rand.New(source{f: f}))
+}
+
+// Registers a gofakeit function under name which generates one of the enum's Consts, for
+// use on plain string fields
+//   enumfake.Register("currency", new(CurrencyCodes))
+//
+//   type Row struct {
+//     CurrencyCode string `fake:"{currency}"`
+//   }
+func Register(name string, e enum.Enummer) {
+	gofakeit.AddFuncLookup(name, gofakeit.Info{
+		Display:     name,
+		Category:    "enum",
+		Description: "Random value of the " + name + " enum",
+		Output:      "string",
+		Generate: func(f *gofakeit.Faker, m *gofakeit.MapParams, info *gofakeit.Info) (any, error) {
+			return string(enum.Random(e, rand.New(source{f: f}))), nil
+		},
+	})
+}
+
+// Lets math/rand draw from a gofakeit Faker so its seed is respected.
+type source struct {
+	f *gofakeit.Faker
+}
+
+func (s source) Int63() int64 {
+	return s.f.Int64() & (1<<63 - 1)
+}
+
+func (s source) Seed(int64) {}
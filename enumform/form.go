@@ -0,0 +1,51 @@
+// Integrates go-enum with github.com/go-playground/form so form values decode into
+// constructed, validated enums
+//   decoder := form.NewDecoder()
+//   enumform.Register(decoder, new(CurrencyCodes), new(OrderStatus))
+//
+//   var req CreateOrder
+//   err := decoder.Decode(&req, r.PostForm) // <-- form.DecodeErrors names any invalid fields
+package enumform
+
+import (
+	"github.com/go-playground/form/v4"
+	"go-enum"
+	"reflect"
+)
+
+// Registers a custom type func with d for each of the enums' types, as well as pointers to
+// them. Values must be valid Consts of the enum. An empty value leaves the enum constructed
+// but unset, as an unselected <select> would
+func Register(d *form.Decoder, enums ...enum.Enummer) {
+	for _, e := range enums {
+		t := reflect.TypeOf(e).Elem()
+		d.RegisterCustomTypeFunc(func(vals []string) (interface{}, error) {
+			v, err := decode(t, vals[0])
+			if err != nil {
+				return nil, err
+			}
+			return v.Elem().Interface(), nil
+		}, reflect.Zero(t).Interface())
+		d.RegisterCustomTypeFunc(func(vals []string) (interface{}, error) {
+			v, err := decode(t, vals[0])
+			if err != nil {
+				return nil, err
+			}
+			return v.Interface(), nil
+		}, reflect.Zero(reflect.PtrTo(t)).Interface())
+	}
+}
+
+// A new, constructed enum of type t holding s.
+func decode(t reflect.Type, s string) (reflect.Value, error) {
+	v := reflect.New(t)
+	e, err := enum.NewE(v.Interface().(enum.Enummer))
+	if err != nil || s == "" {
+		return v, err
+	}
+	c, err := enum.Parse(e, s)
+	if err == nil {
+		err = e.Set(c)
+	}
+	return v, err
+}
@@ -0,0 +1,89 @@
+package enumgen
+
+import (
+	"bufio"
+	"html/template"
+	"io"
+	"strings"
+)
+
+// Writes Markdown documenting enums to w, a section per enum holding a table of its values
+// with their descriptions, deprecations and aliases
+//   ## OrderStatus
+//
+//   | Value | Description | Deprecated | Aliases |
+//   | --- | --- | --- | --- |
+//   | `PENDING` | Waiting to be packed |  | `new` |
+func Markdown(w io.Writer, enums []Enum) error {
+	b := bufio.NewWriter(w)
+	for i, e := range enums {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("## " + e.Name + "\n\n")
+		if doc := strings.TrimSpace(e.Doc); doc != "" {
+			b.WriteString(doc + "\n\n")
+		}
+		b.WriteString("| Value | Description | Deprecated | Aliases |\n| --- | --- | --- | --- |\n")
+		for _, v := range e.Values {
+			aliases := make([]string, len(v.Aliases))
+			for j, a := range v.Aliases {
+				aliases[j] = markdownCode(a)
+			}
+			b.WriteString("| " + strings.Join([]string{
+				markdownCode(v.Value),
+				markdownCell(describe(v)),
+				markdownCell(v.Deprecated),
+				strings.Join(aliases, ", "),
+			}, " | ") + " |\n")
+		}
+	}
+	return b.Flush()
+}
+
+var htmlDocs = template.Must(template.New("docs").Parse(`{{range .}}<section id="{{.Name}}">
+<h2>{{.Name}}</h2>
+{{with .Doc}}<p>{{.}}</p>
+{{end}}<table>
+<thead><tr><th>Value</th><th>Description</th><th>Deprecated</th><th>Aliases</th></tr></thead>
+<tbody>
+{{range .Values}}<tr><td><code>{{.Value}}</code></td><td>{{.Description}}</td><td>{{.Deprecated}}</td><td>{{range $i, $a := .Aliases}}{{if $i}}, {{end}}<code>{{$a}}</code>{{end}}</td></tr>
+{{end}}</tbody>
+</table>
+</section>
+{{end}}`))
+
+// Writes an HTML fragment documenting enums to w, the counterpart of Markdown. Each enum is a
+// section with the enum's name as its id, so other pages can link to it
+func HTML(w io.Writer, enums []Enum) error {
+	out := make([]Enum, len(enums))
+	for i, e := range enums {
+		out[i] = e
+		out[i].Values = make([]Value, len(e.Values))
+		for j, v := range e.Values {
+			v.Description = describe(v)
+			out[i].Values[j] = v
+		}
+	}
+	return htmlDocs.Execute(w, out)
+}
+
+// The description documenting v, taken from its desc tag, its doc comment or its display tag.
+func describe(v Value) string {
+	for _, s := range []string{v.Description, v.Doc, v.Display} {
+		if s = strings.TrimSpace(s); s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// s as inline Markdown code.
+func markdownCode(s string) string {
+	return "`" + strings.ReplaceAll(s, "|", "\\|") + "`"
+}
+
+// s made safe to place in a Markdown table cell.
+func markdownCell(s string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(s)
+}
@@ -0,0 +1,215 @@
+// Generates go-enum structs from enums defined elsewhere, such as an OpenAPI document, and
+// exports go-enum structs to other languages, so every definition of an enum stays in
+// lockstep. Usually run through cmd/goenum
+//   go install go-enum/cmd/goenum
+//   goenum import openapi -pkg api -o enums.go spec.yaml
+//   goenum export ts -o enums.ts ./...
+package enumgen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/pkg/errors"
+	"go-enum"
+	"go/format"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const unsupportedValueErrorMsg = "%s of %s can't be declared in an enum tag"
+
+// The path generated files import go-enum from.
+const importPath = "github.com/eddieowens/go-enum"
+
+// An enum to generate a struct for
+type Enum struct {
+	// The name of the struct
+	Name string
+	// The name of the Go package declaring the struct, set by Load
+	Package string
+	// Documentation for the struct, without comment markers
+	Doc    string
+	Values []Value
+	// The values retired from the enum, which exports reserve so they aren't reused
+	Retired []Value
+}
+
+// A value of an Enum
+type Value struct {
+	Value string
+	// The name of the field declaring the value, derived from Value when empty
+	Field string
+	// Documentation for the field, without comment markers
+	Doc string
+	// The value's display tag
+	Display string
+	// The value's desc tag
+	Description string
+	// Why the value is deprecated, empty if it isn't
+	Deprecated string
+	// The value's integer code, see enum.Enum.Code
+	Code int
+	// Other spellings parsed as the value, from the alias option of its enum tag
+	Aliases []string
+}
+
+// Writes a Go file to w declaring a struct in package pkg for each of the enums
+//   enums, err := enumgen.FromOpenAPI(spec)
+//   err = enumgen.Generate(f, "api", enums)
+func Generate(w io.Writer, pkg string, enums []Enum) error {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by goenum. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import %s\n", strconv.Quote(importPath))
+	for _, e := range enums {
+		b.WriteString("\n")
+		writeDoc(&b, e.Doc, "")
+		fmt.Fprintf(&b, "type %s struct {\n\tenum.Enum\n", e.Name)
+		fields := make(map[string]bool)
+		for _, v := range e.Values {
+			options := append([]string{v.Value, v.Deprecated}, v.Aliases...)
+			if strings.ContainsAny(strings.Join(options, ""), ",`") || strings.Contains(v.Display+v.Description, "`") {
+				return errors.New(fmt.Sprintf(unsupportedValueErrorMsg, strconv.Quote(v.Value), e.Name))
+			}
+			writeDoc(&b, v.Doc, "\t")
+			fmt.Fprintf(&b, "\t%s enum.Const `%s`\n", uniqueName(fields, v), tags(v))
+		}
+		b.WriteString("}\n")
+	}
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// The struct tags declaring v. Deprecation and aliases are given as options of the enum tag.
+func tags(v Value) string {
+	enumTag := v.Value
+	switch v.Deprecated {
+	case "":
+	case "deprecated":
+		enumTag += ",deprecated"
+	default:
+		enumTag += ",deprecated=" + v.Deprecated
+	}
+	for _, a := range v.Aliases {
+		enumTag += ",alias=" + a
+	}
+	out := "enum:" + strconv.Quote(enumTag)
+	if v.Display != "" {
+		out += " display:" + strconv.Quote(v.Display)
+	}
+	if v.Description != "" {
+		out += " desc:" + strconv.Quote(v.Description)
+	}
+	return out
+}
+
+// The Enum described by d
+//   d, _ := enum.Describe(new(CurrencyCodes))
+//   err := enumgen.TypeScript(w, []enumgen.Enum{enumgen.FromDescriptor(d)})
+func FromDescriptor(d *enum.Descriptor) Enum {
+	e := Enum{Name: d.Name()}
+	for _, c := range d.Consts() {
+		v := Value{
+			Value:       string(c.Value),
+			Field:       c.Name,
+			Display:     c.Display,
+			Description: c.Description,
+			Deprecated:  c.Deprecated,
+		}
+		v.Code, _ = d.Code(c.Value)
+		for _, a := range c.Aliases {
+			v.Aliases = append(v.Aliases, string(a))
+		}
+		if c.Retired != "" {
+			e.Retired = append(e.Retired, v)
+		} else {
+			e.Values = append(e.Values, v)
+		}
+	}
+	return e
+}
+
+func writeDoc(b *bytes.Buffer, doc, indent string) {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		if line = strings.TrimRight(line, " \t"); line == "" {
+			fmt.Fprintf(b, "%s//\n", indent)
+		} else {
+			fmt.Fprintf(b, "%s// %s\n", indent, line)
+		}
+	}
+}
+
+// The field name for v, numbered when another field already has it.
+func uniqueName(fields map[string]bool, v Value) string {
+	name := v.Field
+	if name == "" {
+		name = GoName(v.Value)
+	}
+	out := name
+	for i := 2; fields[out]; i++ {
+		out = name + strconv.Itoa(i)
+	}
+	fields[out] = true
+	return out
+}
+
+// Converts s, such as "in_progress", "IN-PROGRESS" or "inProgress", into an exported Go
+// identifier such as "InProgress"
+func GoName(s string) string {
+	var b strings.Builder
+	for _, w := range words(s) {
+		if isUpper(w) {
+			w = strings.ToLower(w)
+		}
+		rs := []rune(w)
+		b.WriteRune(unicode.ToUpper(rs[0]))
+		b.WriteString(string(rs[1:]))
+	}
+	out := b.String()
+	switch {
+	case out == "":
+		return "Empty"
+	case unicode.IsDigit([]rune(out)[0]):
+		return "V" + out
+	}
+	return out
+}
+
+// Splits s into words at anything other than a letter or digit and where lower case turns
+// into upper case.
+func words(s string) []string {
+	var out []string
+	var cur []rune
+	for _, r := range s {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			if len(cur) > 0 {
+				out = append(out, string(cur))
+			}
+			cur = nil
+			continue
+		case unicode.IsUpper(r) && len(cur) > 0 && unicode.IsLower(cur[len(cur)-1]):
+			out = append(out, string(cur))
+			cur = nil
+		}
+		cur = append(cur, r)
+	}
+	if len(cur) > 0 {
+		out = append(out, string(cur))
+	}
+	return out
+}
+
+func isUpper(s string) bool {
+	return strings.ToUpper(s) == s
+}
@@ -0,0 +1,32 @@
+package enumgen
+
+import (
+	"encoding/json"
+)
+
+// Finds every string enum in a JSON Schema, declared either with enum or as a oneOf or anyOf
+// of consts. Schemas under $defs or definitions are named after their key, property schemas
+// after their parent and the property and the root schema after its title. The title and
+// description of each const become the display and desc tags of its Const, and the schema's
+// description documents the struct
+//   {
+//     "$defs": {
+//       "Currency": {
+//         "oneOf": [{"const": "XAU", "title": "Gold", "description": "One troy ounce of gold"}]
+//       }
+//     }
+//   }
+func FromJSONSchema(schema []byte) ([]Enum, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(schema, &doc); err != nil {
+		return nil, err
+	}
+	title, _ := doc["title"].(string)
+	name := ""
+	if title != "" {
+		name = GoName(title)
+	}
+	c := newSchemaCollector()
+	c.walk(doc, "", name)
+	return c.enums, nil
+}
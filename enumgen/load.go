@@ -0,0 +1,261 @@
+package enumgen
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"go-enum"
+	"go/ast"
+	"go/types"
+	"golang.org/x/tools/go/packages"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const loadErrorMsg = "%s: %s"
+
+// Finds the enum structs declared in the packages matching patterns, as go build would, without
+// running any of their code. Each struct's doc comment documents the Enum
+//   enums, err := enumgen.Load("./...")
+func Load(patterns ...string) ([]Enum, error) {
+	cfg := &packages.Config{Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, err
+	}
+	var out []Enum
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, errors.New(fmt.Sprintf(loadErrorMsg, pkg.PkgPath, pkg.Errors[0]))
+		}
+		docs := typeDocs(pkg)
+		scope := pkg.Types.Scope()
+		for _, name := range scope.Names() {
+			tn, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || !tn.Exported() {
+				continue
+			}
+			s, ok := enumStruct(tn.Type())
+			if !ok {
+				continue
+			}
+			e := loadEnum(name, docs[tn], s)
+			e.Package = pkg.Name
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+// The doc comments of the types declared in pkg.
+func typeDocs(pkg *packages.Package) map[*types.TypeName]string {
+	out := make(map[*types.TypeName]string)
+	for _, f := range pkg.Syntax {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				doc := ts.Doc
+				if doc == nil && len(gen.Specs) == 1 {
+					doc = gen.Doc
+				}
+				if tn, ok := pkg.TypesInfo.Defs[ts.Name].(*types.TypeName); ok && doc != nil {
+					out[tn] = strings.TrimSpace(doc.Text())
+				}
+			}
+		}
+	}
+	return out
+}
+
+func isEnumType(t types.Type, name string) bool {
+	n, ok := t.(*types.Named)
+	if !ok || n.Obj().Name() != name || n.Obj().Pkg() == nil {
+		return false
+	}
+	path := n.Obj().Pkg().Path()
+	return path == "go-enum" || strings.HasSuffix(path, "/go-enum")
+}
+
+// Whether fields of type t declare Consts, like they do for enumvet. That is enum.Const or a
+// named string type with an EnumConst method, so helper fields of other string types are left
+// out.
+func isConstType(t types.Type) bool {
+	if isEnumType(t, "Const") {
+		return true
+	}
+	n, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	if b, ok := n.Underlying().(*types.Basic); !ok || b.Kind() != types.String {
+		return false
+	}
+	m, _, _ := types.LookupFieldOrMethod(n, false, n.Obj().Pkg(), "EnumConst")
+	f, ok := m.(*types.Func)
+	if !ok {
+		return false
+	}
+	sig := f.Type().(*types.Signature)
+	return sig.Params().Len() == 0 && sig.Results().Len() == 0
+}
+
+// The struct behind t if it embeds enum.Enum.
+func enumStruct(t types.Type) (*types.Struct, bool) {
+	s, ok := t.Underlying().(*types.Struct)
+	if !ok || isEnumType(t, "Enum") {
+		return nil, false
+	}
+	for i := 0; i < s.NumFields(); i++ {
+		if f := s.Field(i); f.Embedded() && isEnumType(f.Type(), "Enum") {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// A Value read from a Const field, along with the settings placing it among the others.
+type loadedValue struct {
+	Value
+	order   int
+	ordered bool
+	coded   bool
+	retired bool
+}
+
+// The Enum declared by the struct s, with retired values kept apart.
+func loadEnum(name, doc string, s *types.Struct) Enum {
+	e := Enum{Name: name, Doc: doc}
+	for _, v := range loadValues(s) {
+		if v.retired {
+			e.Retired = append(e.Retired, v.Value)
+		} else {
+			e.Values = append(e.Values, v.Value)
+		}
+	}
+	return e
+}
+
+// The values declared by the Const fields of s and the enum structs embedded in it, in the
+// order of their ordinals, mirroring how the enum is constructed.
+func loadValues(s *types.Struct) []loadedValue {
+	var typeTag reflect.StructTag
+	for i := 0; i < s.NumFields(); i++ {
+		if f := s.Field(i); f.Embedded() && isEnumType(f.Type(), "Enum") {
+			typeTag = reflect.StructTag(s.Tag(i))
+		}
+	}
+	naming := enum.Case(typeTag.Get("case"))
+	if _, ok := typeTag.Lookup("case"); !ok && typeTag.Get("format") == "proto" {
+		naming = enum.ScreamingSnakeCase
+	}
+	var loaded []loadedValue
+	seen := make(map[string]bool)
+	for i := 0; i < s.NumFields(); i++ {
+		f := s.Field(i)
+		tag := reflect.StructTag(s.Tag(i))
+		if !f.Exported() || tag.Get("enum") == "-" {
+			continue
+		}
+		if sub, ok := enumStruct(f.Type()); ok && f.Embedded() {
+			for _, v := range loadValues(sub) {
+				if !seen[v.Value.Value] {
+					seen[v.Value.Value] = true
+					loaded = append(loaded, v)
+				}
+			}
+			continue
+		}
+		if !isConstType(f.Type()) {
+			continue
+		}
+		opts := tagOptions(tag)
+		v := loadedValue{Value: Value{Value: strings.Split(tag.Get("enum"), ",")[0], Field: f.Name()}}
+		if v.Value.Value == "" {
+			var ok bool
+			if v.Value.Value, ok = naming.Apply(f.Name()); !ok {
+				continue
+			}
+		}
+		v.Display = opts["display"]
+		v.Description = opts["desc"]
+		v.Aliases = tagAliases(tag)
+		if msg, ok := opts["deprecated"]; ok {
+			if v.Deprecated = msg; msg == "" {
+				v.Deprecated = "deprecated"
+			}
+		}
+		_, v.retired = opts["retired"]
+		if order, err := strconv.Atoi(opts["order"]); err == nil {
+			v.order, v.ordered = order, true
+		}
+		if code, err := strconv.Atoi(opts["code"]); err == nil {
+			v.Code, v.coded = code, true
+		}
+		seen[v.Value.Value] = true
+		loaded = append(loaded, v)
+	}
+	if f := typeTag.Get("format"); f == "int" || f == "proto" {
+		numberValues(loaded, typeTag.Get("start"))
+	}
+	sort.SliceStable(loaded, func(i, j int) bool {
+		a, b := loaded[i], loaded[j]
+		if a.ordered != b.ordered {
+			return a.ordered
+		}
+		return a.order < b.order
+	})
+	for i := range loaded {
+		if !loaded[i].coded {
+			loaded[i].Code = i
+		}
+	}
+	return loaded
+}
+
+// Numbers the values of an enum encoded as an integer in the order they are declared, starting
+// at start, unless any of them has a code tag.
+func numberValues(loaded []loadedValue, start string) {
+	for _, v := range loaded {
+		if v.coded {
+			return
+		}
+	}
+	n, _ := strconv.Atoi(start)
+	for i := range loaded {
+		loaded[i].Code, loaded[i].coded = n+i, true
+	}
+}
+
+// The settings of a Const field, from both its own tags and the options of its enum tag.
+func tagOptions(tag reflect.StructTag) map[string]string {
+	out := make(map[string]string)
+	for _, key := range []string{"display", "desc", "deprecated", "retired", "order", "code"} {
+		if v, ok := tag.Lookup(key); ok {
+			out[key] = v
+		}
+	}
+	for _, opt := range strings.Split(tag.Get("enum"), ",")[1:] {
+		k, v, _ := strings.Cut(opt, "=")
+		out[strings.TrimSpace(k)] = v
+	}
+	return out
+}
+
+// The aliases given as options of an enum tag. Unlike other options alias may be repeated.
+func tagAliases(tag reflect.StructTag) []string {
+	var out []string
+	for _, opt := range strings.Split(tag.Get("enum"), ",")[1:] {
+		if k, v, _ := strings.Cut(opt, "="); strings.TrimSpace(k) == "alias" && v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
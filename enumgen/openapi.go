@@ -0,0 +1,23 @@
+package enumgen
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// Finds every string schema with an enum in an OpenAPI document, either YAML or JSON. Schemas
+// under components are named after their key and property schemas after their parent and the
+// property, so the status property of Order becomes OrderStatus. Parameters are named after
+// the parameter and schemas inline in an operation after its operationId. The schema's
+// description documents the struct, and the x-enum-varnames and x-enum-descriptions
+// extensions name and document its fields
+//   spec, _ := os.ReadFile("spec.yaml")
+//   enums, err := enumgen.FromOpenAPI(spec)
+func FromOpenAPI(spec []byte) ([]Enum, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(spec, &doc); err != nil {
+		return nil, err
+	}
+	c := newSchemaCollector()
+	c.walk(doc, "", "")
+	return c.enums, nil
+}
@@ -0,0 +1,53 @@
+package enumgen
+
+import (
+	"context"
+	"database/sql"
+)
+
+const postgresEnumsQuery = `SELECT n.nspname, t.typname, e.enumlabel, COALESCE(d.description, '')
+FROM pg_type t
+JOIN pg_enum e ON e.enumtypid = t.oid
+JOIN pg_namespace n ON n.oid = t.typnamespace
+LEFT JOIN pg_description d ON d.objoid = t.oid AND d.objsubid = 0
+ORDER BY n.nspname, t.typname, e.enumsortorder`
+
+// Reads the native enum types of the Postgres database behind db, with their labels in the
+// order Postgres sorts them. Only types in the provided schemas are read, or every schema if
+// none are provided. Types outside the public schema are prefixed with their schema so
+// billing.currency becomes BillingCurrency. The type's comment documents the struct
+//   db, _ := sql.Open("pgx", dsn)
+//   enums, err := enumgen.FromPostgres(ctx, db, "public")
+func FromPostgres(ctx context.Context, db *sql.DB, schemas ...string) ([]Enum, error) {
+	keep := make(map[string]bool, len(schemas))
+	for _, s := range schemas {
+		keep[s] = true
+	}
+	rows, err := db.QueryContext(ctx, postgresEnumsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []Enum
+	var last string
+	for rows.Next() {
+		var schema, typ, label, doc string
+		if err := rows.Scan(&schema, &typ, &label, &doc); err != nil {
+			return nil, err
+		}
+		if len(keep) > 0 && !keep[schema] {
+			continue
+		}
+		if key := schema + "." + typ; key != last {
+			name := GoName(typ)
+			if schema != "public" {
+				name = GoName(schema) + name
+			}
+			out = append(out, Enum{Name: name, Doc: doc})
+			last = key
+		}
+		e := &out[len(out)-1]
+		e.Values = append(e.Values, Value{Value: label})
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,97 @@
+package enumgen
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/pkg/errors"
+	"go-enum"
+	"io"
+	"strings"
+	"unicode"
+)
+
+const reservedZeroErrorMsg = "%s has no value with code 0 and its retired %s holds it, proto3 enums must start at 0"
+
+// Writes a proto3 file to w declaring an enum for each of enums, in package pkg if it isn't
+// empty. Each value is numbered by its code, so enums tagged format:"int" keep their integers,
+// and named after its value in upper snake case prefixed with the enum's name as proto style
+// expects. Retired values are reserved so their numbers and names aren't reused. Enums without
+// a value numbered 0 get an UNSPECIFIED one since proto3 requires it
+//   enum OrderStatus {
+//     reserved 3;
+//     reserved "ORDER_STATUS_LOST";
+//     ORDER_STATUS_UNSPECIFIED = 0;
+//     ORDER_STATUS_PENDING = 1;
+//     ORDER_STATUS_SHIPPED = 2;
+//   }
+func Proto(w io.Writer, pkg string, enums []Enum) error {
+	b := bufio.NewWriter(w)
+	b.WriteString("// Code generated by goenum. DO NOT EDIT.\n\nsyntax = \"proto3\";\n")
+	if pkg != "" {
+		fmt.Fprintf(b, "\npackage %s;\n", pkg)
+	}
+	for _, e := range enums {
+		prefix := protoPrefix(e.Name)
+		zero := false
+		for _, v := range e.Values {
+			zero = zero || v.Code == 0
+		}
+		b.WriteString("\n")
+		writeProtoDoc(b, "", e.Doc)
+		fmt.Fprintf(b, "enum %s {\n", e.Name)
+		for _, v := range e.Retired {
+			if v.Code == 0 && !zero {
+				return errors.New(fmt.Sprintf(reservedZeroErrorMsg, e.Name, v.Value))
+			}
+			fmt.Fprintf(b, "  reserved %d;\n  reserved %q;\n", v.Code, protoName(prefix, v.Value))
+		}
+		if !zero {
+			fmt.Fprintf(b, "  %sUNSPECIFIED = 0;\n", prefix)
+		}
+		for _, v := range e.Values {
+			doc := v.Description
+			if doc == "" {
+				doc = v.Doc
+			}
+			writeProtoDoc(b, "  ", doc)
+			opts := ""
+			if v.Deprecated != "" {
+				opts = " [deprecated = true]"
+			}
+			fmt.Fprintf(b, "  %s = %d%s;\n", protoName(prefix, v.Value), v.Code, opts)
+		}
+		b.WriteString("}\n")
+	}
+	return b.Flush()
+}
+
+// The prefix of the values of the enum called name, such as "ORDER_STATUS_" for OrderStatus.
+func protoPrefix(name string) string {
+	snake, _ := enum.SnakeCase.Apply(name)
+	return strings.ToUpper(snake) + "_"
+}
+
+// The proto name of value, in upper snake case and starting with prefix. Anything which can't
+// be part of an identifier becomes an underscore.
+func protoName(prefix, value string) string {
+	snake, _ := enum.SnakeCase.Apply(value)
+	name := strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return '_'
+		}
+		return unicode.ToUpper(r)
+	}, snake)
+	if strings.HasPrefix(name, prefix) {
+		return name
+	}
+	return prefix + name
+}
+
+func writeProtoDoc(b *bufio.Writer, indent, doc string) {
+	if doc = strings.TrimSpace(doc); doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		b.WriteString(indent + "// " + strings.TrimSpace(line) + "\n")
+	}
+}
@@ -0,0 +1,158 @@
+package enumgen
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Collects the enums declared by the schemas of an OpenAPI document or JSON Schema.
+type schemaCollector struct {
+	enums  []Enum
+	byName map[string]int
+}
+
+func newSchemaCollector() *schemaCollector {
+	return &schemaCollector{byName: make(map[string]int)}
+}
+
+// Walks node, a part of the document found under key, collecting its enums. name is what an
+// enum found in node would be called.
+func (c *schemaCollector) walk(node interface{}, key, name string) {
+	switch n := node.(type) {
+	case []interface{}:
+		for _, item := range n {
+			c.walk(item, key, name)
+		}
+	case map[string]interface{}:
+		if id, ok := n["operationId"].(string); ok {
+			name = GoName(id)
+		}
+		if p, ok := n["name"].(string); ok && key == "parameters" {
+			name = GoName(p)
+		}
+		if e, ok := schemaEnum(n, name); ok {
+			c.add(e)
+		}
+		keys := make([]string, 0, len(n))
+		for k := range n {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			switch key {
+			case "schemas", "definitions", "$defs":
+				c.walk(n[k], k, GoName(k))
+			case "properties":
+				c.walk(n[k], k, name+GoName(k))
+			default:
+				c.walk(n[k], k, name)
+			}
+		}
+	}
+}
+
+// Adds e unless it has already been found, as happens when a parameter is shared. Different
+// enums with the same name are told apart by a number.
+func (c *schemaCollector) add(e Enum) {
+	base := e.Name
+	for i := 2; ; i++ {
+		j, ok := c.byName[e.Name]
+		if !ok {
+			break
+		}
+		if reflect.DeepEqual(c.enums[j], e) {
+			return
+		}
+		e.Name = base + strconv.Itoa(i)
+	}
+	c.byName[e.Name] = len(c.enums)
+	c.enums = append(c.enums, e)
+}
+
+// The enum declared by schema, if it is a string schema with a name and either an enum or a
+// oneOf or anyOf made up of consts.
+func schemaEnum(schema map[string]interface{}, name string) (Enum, bool) {
+	if name == "" || !isStringSchema(schema["type"]) {
+		return Enum{}, false
+	}
+	e := Enum{Name: name}
+	e.Doc, _ = schema["description"].(string)
+	var ok bool
+	if values, isEnum := schema["enum"].([]interface{}); isEnum {
+		e.Values, ok = enumValues(schema, values)
+	} else if consts, isOneOf := schema["oneOf"].([]interface{}); isOneOf {
+		e.Values, ok = constValues(consts)
+	} else if consts, isAnyOf := schema["anyOf"].([]interface{}); isAnyOf {
+		e.Values, ok = constValues(consts)
+	}
+	return e, ok && len(e.Values) > 0
+}
+
+// The values of an enum keyword, named and documented by the x-enum-varnames and
+// x-enum-descriptions extensions of the schema. A null value is left out.
+func enumValues(schema map[string]interface{}, values []interface{}) ([]Value, bool) {
+	names, _ := schema["x-enum-varnames"].([]interface{})
+	docs, _ := schema["x-enum-descriptions"].([]interface{})
+	var out []Value
+	for i, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			if v == nil {
+				continue
+			}
+			return nil, false
+		}
+		val := Value{Value: s}
+		if i < len(names) {
+			val.Field, _ = names[i].(string)
+		}
+		if i < len(docs) {
+			val.Doc, _ = docs[i].(string)
+		}
+		out = append(out, val)
+	}
+	return out, true
+}
+
+// The values of a oneOf or anyOf whose schemas are all string consts, leaving out null.
+func constValues(schemas []interface{}) ([]Value, bool) {
+	var out []Value
+	for _, s := range schemas {
+		m, ok := s.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, has := m["const"]; (has && v == nil) || m["type"] == "null" {
+			continue
+		}
+		c, ok := m["const"].(string)
+		if !ok {
+			return nil, false
+		}
+		v := Value{Value: c}
+		v.Display, _ = m["title"].(string)
+		v.Description, _ = m["description"].(string)
+		out = append(out, v)
+	}
+	return out, true
+}
+
+// Whether t, the type of a schema, is string. OpenAPI 3.1 and JSON Schema allow a list of
+// types such as [string, null] and a schema without a type is taken to be a string if its
+// values are.
+func isStringSchema(t interface{}) bool {
+	switch t := t.(type) {
+	case nil:
+		return true
+	case string:
+		return t == "string"
+	case []interface{}:
+		for _, s := range t {
+			if s == "string" {
+				return true
+			}
+		}
+	}
+	return false
+}
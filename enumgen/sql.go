@@ -0,0 +1,94 @@
+package enumgen
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/pkg/errors"
+	"go-enum"
+	"io"
+	"strings"
+)
+
+const unknownDialectErrorMsg = "%q is not a supported dialect, expected postgres"
+
+// Writes SQL to w which brings the native enum types of a database in line with enums. existing
+// holds the enum types already in the database, as read by FromPostgres, and is matched to
+// enums by name. Missing types are created and values missing from existing types are added
+// in place. Values can't be dropped from a Postgres enum so any the database has beyond those
+// of the enum are only noted in a comment. Each type is named after its enum in snake case.
+// postgres is the only dialect supported
+//   existing, _ := enumgen.FromPostgres(ctx, db)
+//   err := enumgen.SQL(w, "postgres", enums, existing)
+func SQL(w io.Writer, dialect string, enums, existing []Enum) error {
+	if dialect != "postgres" {
+		return errors.New(fmt.Sprintf(unknownDialectErrorMsg, dialect))
+	}
+	byName := make(map[string]Enum, len(existing))
+	for _, e := range existing {
+		byName[e.Name] = e
+	}
+	b := bufio.NewWriter(w)
+	b.WriteString("-- Code generated by goenum. DO NOT EDIT.\n")
+	for _, e := range enums {
+		name, _ := enum.SnakeCase.Apply(e.Name)
+		typ := quoteIdent(name)
+		old, ok := byName[e.Name]
+		if !ok {
+			values := make([]string, len(e.Values))
+			for i, v := range e.Values {
+				values[i] = quoteLiteral(v.Value)
+			}
+			fmt.Fprintf(b, "\nCREATE TYPE %s AS ENUM (%s);\n", typ, strings.Join(values, ", "))
+			continue
+		}
+		has := make(map[string]bool, len(old.Values))
+		for _, v := range old.Values {
+			has[v.Value] = true
+		}
+		var stmts []string
+		for i, v := range e.Values {
+			if has[v.Value] {
+				continue
+			}
+			where := ""
+			if i > 0 {
+				where = " AFTER " + quoteLiteral(e.Values[i-1].Value)
+			} else if next, ok := firstExisting(e.Values, has); ok {
+				where = " BEFORE " + quoteLiteral(next)
+			}
+			stmts = append(stmts, fmt.Sprintf("ALTER TYPE %s ADD VALUE IF NOT EXISTS %s%s;\n", typ, quoteLiteral(v.Value), where))
+			has[v.Value] = true
+		}
+		keep := make(map[string]bool, len(e.Values)+len(e.Retired))
+		for _, v := range append(append([]Value(nil), e.Values...), e.Retired...) {
+			keep[v.Value] = true
+		}
+		for _, v := range old.Values {
+			if !keep[v.Value] {
+				stmts = append(stmts, fmt.Sprintf("-- %s still holds %s, which %s no longer declares\n", typ, quoteLiteral(v.Value), e.Name))
+			}
+		}
+		if len(stmts) > 0 {
+			b.WriteString("\n" + strings.Join(stmts, ""))
+		}
+	}
+	return b.Flush()
+}
+
+// The first of values already in the database.
+func firstExisting(values []Value, has map[string]bool) (string, bool) {
+	for _, v := range values {
+		if has[v.Value] {
+			return v.Value, true
+		}
+	}
+	return "", false
+}
+
+func quoteIdent(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
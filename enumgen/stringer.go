@@ -0,0 +1,52 @@
+package enumgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"strconv"
+)
+
+// Writes a Go file to w declaring the named integer type typ with a constant per value of e
+// and a String method, the same API golang.org/x/tools/cmd/stringer generates, so code built
+// around stringer can move its definition onto an enum struct. Each constant is named after
+// its field and numbered by its code, and String returns its value. The file is in e's Package
+//   type Pills struct {
+//     enum.Enum `format:"int"`
+//     Placebo   enum.Const
+//     Aspirin   enum.Const
+//   }
+//
+//   //go:generate goenum -stringer -type Pill -enum Pills
+//   fmt.Println(Aspirin) // Prints "Aspirin"
+func Stringer(w io.Writer, typ string, e Enum) error {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by goenum -stringer. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\nimport \"strconv\"\n\n", e.Package)
+	writeDoc(&b, e.Doc, "")
+	fmt.Fprintf(&b, "type %s int\n\nconst (\n", typ)
+	fields := make(map[string]bool)
+	names := make([]string, len(e.Values))
+	for i, v := range e.Values {
+		names[i] = uniqueName(fields, v)
+		doc := describe(v)
+		if v.Deprecated != "" {
+			doc += "\n\nDeprecated: " + v.Deprecated
+		}
+		writeDoc(&b, doc, "\t")
+		fmt.Fprintf(&b, "\t%s %s = %d\n", names[i], typ, v.Code)
+	}
+	b.WriteString(")\n\n")
+	fmt.Fprintf(&b, "func (i %s) String() string {\n\tswitch i {\n", typ)
+	for i, v := range e.Values {
+		fmt.Fprintf(&b, "\tcase %s:\n\t\treturn %s\n", names[i], strconv.Quote(v.Value))
+	}
+	fmt.Fprintf(&b, "\t}\n\treturn %s + strconv.FormatInt(int64(i), 10) + \")\"\n}\n", strconv.Quote(typ+"("))
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
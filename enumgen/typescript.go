@@ -0,0 +1,98 @@
+package enumgen
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Writes a TypeScript module to w declaring, for each enum, a const object mapping its field
+// names to its values and a union type of the values under the same name. Descriptions and
+// deprecations become JSDoc
+//   export const OrderStatus = {
+//     Pending: "PENDING",
+//     Shipped: "SHIPPED",
+//   } as const;
+//
+//   export type OrderStatus = (typeof OrderStatus)[keyof typeof OrderStatus];
+func TypeScript(w io.Writer, enums []Enum) error {
+	b := bufio.NewWriter(w)
+	b.WriteString("// Code generated by goenum. DO NOT EDIT.\n")
+	for _, e := range enums {
+		b.WriteString("\n")
+		writeJSDoc(b, "", e.Doc, "")
+		b.WriteString("export const " + e.Name + " = {\n")
+		writeMembers(b, e, func(name, value string) string { return name + ": " + value + "," })
+		b.WriteString("} as const;\n\n")
+		writeJSDoc(b, "", e.Doc, "")
+		b.WriteString("export type " + e.Name + " = (typeof " + e.Name + ")[keyof typeof " + e.Name + "];\n")
+	}
+	return b.Flush()
+}
+
+// Writes a TypeScript declaration file to w, the .d.ts counterpart of TypeScript, declaring
+// the union type of each enum's values and the const object holding them
+//   export type OrderStatus = "PENDING" | "SHIPPED";
+func TypeScriptDeclarations(w io.Writer, enums []Enum) error {
+	b := bufio.NewWriter(w)
+	b.WriteString("// Code generated by goenum. DO NOT EDIT.\n")
+	for _, e := range enums {
+		b.WriteString("\n")
+		writeJSDoc(b, "", e.Doc, "")
+		values := make([]string, len(e.Values))
+		for i, v := range e.Values {
+			values[i] = strconv.Quote(v.Value)
+		}
+		if len(values) == 0 {
+			values = []string{"never"}
+		}
+		b.WriteString("export type " + e.Name + " = " + strings.Join(values, " | ") + ";\n\n")
+		writeJSDoc(b, "", e.Doc, "")
+		b.WriteString("export declare const " + e.Name + ": {\n")
+		writeMembers(b, e, func(name, value string) string { return "readonly " + name + ": " + value + ";" })
+		b.WriteString("};\n")
+	}
+	return b.Flush()
+}
+
+// Writes a member of an object per value of e, keyed by the value's field name.
+func writeMembers(b *bufio.Writer, e Enum, member func(name, value string) string) {
+	fields := make(map[string]bool)
+	for _, v := range e.Values {
+		doc := v.Description
+		if doc == "" {
+			doc = v.Doc
+		}
+		writeJSDoc(b, "  ", doc, v.Deprecated)
+		b.WriteString("  " + member(uniqueName(fields, v), strconv.Quote(v.Value)) + "\n")
+	}
+}
+
+// Writes doc and the deprecation, if there is one, as a JSDoc comment.
+func writeJSDoc(b *bufio.Writer, indent, doc, deprecated string) {
+	var lines []string
+	if doc = strings.TrimSpace(doc); doc != "" {
+		lines = strings.Split(doc, "\n")
+	}
+	if deprecated != "" {
+		lines = append(lines, "@deprecated "+deprecated)
+	}
+	switch len(lines) {
+	case 0:
+		return
+	case 1:
+		b.WriteString(indent + "/** " + jsDocText(lines[0]) + " */\n")
+		return
+	}
+	b.WriteString(indent + "/**\n")
+	for _, l := range lines {
+		b.WriteString(indent + " * " + jsDocText(l) + "\n")
+	}
+	b.WriteString(indent + " */\n")
+}
+
+// s made safe to place in a JSDoc comment.
+func jsDocText(s string) string {
+	return strings.ReplaceAll(strings.TrimSpace(s), "*/", "*\\/")
+}
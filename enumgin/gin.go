@@ -0,0 +1,65 @@
+// Integrates go-enum with github.com/gin-gonic/gin so enums in bound requests are validated.
+// Enums are read from JSON bodies as well as query, form and path parameters
+//   binding.Validator = enumgin.NewValidator(binding.Validator)
+//
+//   var req ListOrders
+//   if !enumgin.Bind(c, &req) {
+//     return // <-- a 400 listing the invalid fields has been sent
+//   }
+package enumgin
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"go-enum"
+	"net/http"
+)
+
+// The body of the response Bind sends for a request that can't be bound, see
+// enum.ErrorResponse
+type ErrorResponse = enum.ErrorResponse
+
+// A problem with a single field of a request
+type FieldError = enum.ErrorDetail
+
+type validator struct {
+	next binding.StructValidator
+}
+
+// Returns a binding.StructValidator which validates every enum in the bound value with
+// enum.ValidateAll before handing it to next, if next isn't nil. Set it as gin's
+// binding.Validator so ShouldBind and friends reject invalid enums
+func NewValidator(next binding.StructValidator) binding.StructValidator {
+	return &validator{next: next}
+}
+
+func (v *validator) ValidateStruct(obj interface{}) error {
+	if err := enum.ValidateAll(obj); err != nil {
+		return err
+	}
+	if v.next == nil {
+		return nil
+	}
+	return v.next.ValidateStruct(obj)
+}
+
+func (v *validator) Engine() interface{} {
+	if v.next == nil {
+		return nil
+	}
+	return v.next.Engine()
+}
+
+// Binds the request into obj like c.ShouldBind, then validates every enum in obj. If either
+// fails the request is aborted with a 400 holding an ErrorResponse and false is returned
+func Bind(c *gin.Context, obj interface{}) bool {
+	err := c.ShouldBind(obj)
+	if err == nil {
+		err = enum.ValidateAll(obj)
+	}
+	if err == nil {
+		return true
+	}
+	c.AbortWithStatusJSON(http.StatusBadRequest, enum.NewErrorResponse(err))
+	return false
+}
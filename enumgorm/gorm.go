@@ -0,0 +1,159 @@
+// Integrates go-enum with gorm.io/gorm. Importing the package registers the enum serializer,
+// which stores an enum as its Const and validates it when read back
+//   type Payment struct {
+//     ID           uint
+//     CurrencyCode CurrencyCodes `gorm:"serializer:enum"`
+//   }
+//
+//   func (CurrencyCodes) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+//     return enumgorm.DBDataType(db, new(CurrencyCodes))
+//   }
+package enumgorm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"go-enum"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// The name the serializer is registered under
+const SerializerName = "enum"
+
+const notEnumErrorMsg = "%s is not an enum"
+const unsupportedValueErrorMsg = "cannot scan %T into %s"
+
+func init() {
+	schema.RegisterSerializer(SerializerName, Serializer{})
+}
+
+// Reads and writes enums as their Const. Values read from the database are validated
+// leniently so rows holding retired Consts can still be loaded
+type Serializer struct{}
+
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	fieldValue := reflect.New(field.FieldType)
+	if dbValue != nil {
+		var s string
+		switch v := dbValue.(type) {
+		case string:
+			s = v
+		case []byte:
+			s = string(v)
+		default:
+			return errors.New(fmt.Sprintf(unsupportedValueErrorMsg, dbValue, field.Name))
+		}
+		e, err := enummer(fieldValue)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(strconv.Quote(s)), e); err != nil {
+			return err
+		}
+		if err := enum.Validate(e, enum.Lenient()); err != nil {
+			return &enum.FieldError{Field: field.Name, Err: err}
+		}
+	}
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	v := reflect.New(field.FieldType)
+	if fieldValue != nil {
+		v.Elem().Set(reflect.ValueOf(fieldValue))
+	}
+	e, err := enummer(v)
+	if err != nil || e.Get() == "" {
+		return nil, err
+	}
+	if err := enum.Validate(e); err != nil {
+		return nil, &enum.FieldError{Field: field.Name, Err: err}
+	}
+	return string(e.Get()), nil
+}
+
+// The column type for the enum under db's dialect. MySQL gets a native ENUM of every Const,
+// Postgres gets the type created by Migrate and anything else a varchar wide enough for the
+// longest Const. Retired Consts are included so existing rows stay valid
+func DBDataType(db *gorm.DB, e enum.Enummer) string {
+	d, err := enum.Describe(e)
+	if err != nil {
+		_ = db.AddError(err)
+		return ""
+	}
+	switch db.Dialector.Name() {
+	case "mysql":
+		return "ENUM(" + quoted(d) + ")"
+	case "postgres":
+		return TypeName(d)
+	}
+	size := 1
+	for _, c := range d.Consts() {
+		if len(c.Value) > size {
+			size = len(c.Value)
+		}
+	}
+	return fmt.Sprintf("varchar(%d)", size)
+}
+
+// The name of the Postgres type Migrate creates for the enum. Postgres folds unquoted
+// names to lower case so the enum's name is lower cased to match
+func TypeName(d *enum.Descriptor) string {
+	return strings.ToLower(d.Name())
+}
+
+// Creates a native Postgres enum type for each enum, skipping those that already exist.
+// Does nothing on other dialects. Must be run before AutoMigrate
+//   err := enumgorm.Migrate(db, new(CurrencyCodes))
+//   err = db.AutoMigrate(&Payment{})
+func Migrate(db *gorm.DB, enums ...enum.Enummer) error {
+	if db.Dialector.Name() != "postgres" {
+		return nil
+	}
+	for _, e := range enums {
+		d, err := enum.Describe(e)
+		if err != nil {
+			return err
+		}
+		err = db.Exec(fmt.Sprintf(
+			"DO $$ BEGIN CREATE TYPE %s AS ENUM (%s); EXCEPTION WHEN duplicate_object THEN null; END $$;",
+			TypeName(d), quoted(d),
+		)).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Gets the Enummer held by v, a pointer to an enum or to a pointer to one. A nil inner
+// pointer is replaced with a new enum.
+func enummer(v reflect.Value) (enum.Enummer, error) {
+	if v.Elem().Kind() == reflect.Ptr {
+		if v.Elem().IsNil() {
+			v.Elem().Set(reflect.New(v.Elem().Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	e, ok := v.Interface().(enum.Enummer)
+	if !ok {
+		return nil, errors.New(fmt.Sprintf(notEnumErrorMsg, v.Type().Elem()))
+	}
+	return e, nil
+}
+
+func quoted(d *enum.Descriptor) string {
+	consts := d.Consts()
+	out := make([]string, len(consts))
+	for i, c := range consts {
+		out[i] = "'" + strings.ReplaceAll(string(c.Value), "'", "''") + "'"
+	}
+	return strings.Join(out, ",")
+}
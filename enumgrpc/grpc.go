@@ -0,0 +1,46 @@
+// Integrates go-enum with google.golang.org/grpc so services reject requests holding invalid
+// enums before they reach the handler
+//   srv := grpc.NewServer(grpc.UnaryInterceptor(enumgrpc.UnaryServerInterceptor()))
+package enumgrpc
+
+import (
+	"context"
+	"go-enum"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Validates every enum reachable from the decoded request with enum.ValidateAll. Requests
+// holding an invalid enum are answered with InvalidArgument, carrying a BadRequest detail
+// with a violation for each offending field, and the handler is never called
+//   srv := grpc.NewServer(grpc.UnaryInterceptor(enumgrpc.UnaryServerInterceptor()))
+func UnaryServerInterceptor(opts ...enum.Option) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := enum.ValidateAll(req, opts...); err != nil {
+			return nil, invalidArgument(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// The InvalidArgument status for err, with the invalid enums in err as field violations.
+func invalidArgument(err error) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	invalid := enum.InvalidValuesIn(err)
+	if len(invalid) == 0 {
+		return st.Err()
+	}
+	br := new(errdetails.BadRequest)
+	for _, e := range invalid {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       e.Field,
+			Description: e.Error(),
+		})
+	}
+	if detailed, err := st.WithDetails(br); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,317 @@
+// Integrates go-enum with github.com/google/jsonapi. The reflection jsonapi uses for attributes
+// treats an enum as a nested object, so unmarshalling a plain string attribute into one fails.
+// These functions wrap jsonapi's so enum attributes are written as plain values and read back
+// into constructed, validated enums. Written against jsonapi v1.0.0, which modules importing
+// enumjsonapi must require
+//   go get github.com/google/jsonapi@v1.0.0
+//
+//   type Order struct {
+//     ID     string      `jsonapi:"primary,orders"`
+//     Status OrderStatus `jsonapi:"attr,status"`
+//   }
+//
+//   err := enumjsonapi.MarshalPayload(w, &order) // {"data":{"type":"orders","id":"1","attributes":{"status":"PAID"}}}
+//   err = enumjsonapi.UnmarshalPayload(r.Body, &order)
+package enumjsonapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/google/jsonapi"
+	"go-enum"
+	"io"
+	"reflect"
+	"strings"
+)
+
+var enummerType = reflect.TypeOf((*enum.Enummer)(nil)).Elem()
+
+// Marshals models like jsonapi.Marshal, replacing every enum attribute of the resulting nodes,
+// included ones too, with the enum's JSON value
+func Marshal(models interface{}) (jsonapi.Payloader, error) {
+	p, err := jsonapi.Marshal(models)
+	if err != nil {
+		return nil, err
+	}
+	switch p := p.(type) {
+	case *jsonapi.OnePayload:
+		err = flatten(append([]*jsonapi.Node{p.Data}, p.Included...))
+	case *jsonapi.ManyPayload:
+		err = flatten(append(p.Data, p.Included...))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Writes models as a JSON:API document like jsonapi.MarshalPayload, see Marshal
+func MarshalPayload(w io.Writer, models interface{}) error {
+	p, err := Marshal(models)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(p)
+}
+
+// Reads a JSON:API document holding a single resource into model like jsonapi.UnmarshalPayload.
+// Enum attributes of the resource, and of included resources it relates to, are set on
+// constructed enums and every enum in model is then checked with enum.ValidateAll
+//   var order Order
+//   if err := enumjsonapi.UnmarshalPayload(r.Body, &order); err != nil {
+//     // <-- the body is malformed or holds invalid enums
+//   }
+func UnmarshalPayload(in io.Reader, model interface{}, opts ...enum.Option) error {
+	payload := new(jsonapi.OnePayload)
+	if err := json.NewDecoder(in).Decode(payload); err != nil {
+		return err
+	}
+	types := modelTypes(reflect.TypeOf(model), nil)
+	attrs := strip(types, []*jsonapi.Node{payload.Data})
+	included := stripIncluded(types, payload.Included)
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if err := jsonapi.UnmarshalPayload(bytes.NewReader(b), model); err != nil {
+		return err
+	}
+	v := reflect.ValueOf(model)
+	if err := setAttrs(v, attrs[0]); err != nil {
+		return err
+	}
+	if err := setIncluded(v, included, make(map[reflect.Value]bool)); err != nil {
+		return err
+	}
+	return enum.ValidateAll(model, opts...)
+}
+
+// Reads a JSON:API document holding many resources of type t like
+// jsonapi.UnmarshalManyPayload, see UnmarshalPayload
+//   models, err := enumjsonapi.UnmarshalManyPayload(r.Body, reflect.TypeOf(new(Order)))
+func UnmarshalManyPayload(in io.Reader, t reflect.Type, opts ...enum.Option) ([]interface{}, error) {
+	payload := new(jsonapi.ManyPayload)
+	if err := json.NewDecoder(in).Decode(payload); err != nil {
+		return nil, err
+	}
+	types := modelTypes(t, nil)
+	attrs := strip(types, payload.Data)
+	included := stripIncluded(types, payload.Included)
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	models, err := jsonapi.UnmarshalManyPayload(bytes.NewReader(b), t)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[reflect.Value]bool)
+	for i, m := range models {
+		v := reflect.ValueOf(m)
+		if i < len(attrs) {
+			if err := setAttrs(v, attrs[i]); err != nil {
+				return nil, err
+			}
+		}
+		if err := setIncluded(v, included, seen); err != nil {
+			return nil, err
+		}
+	}
+	if err := enum.ValidateAll(models, opts...); err != nil {
+		return nil, err
+	}
+	return models, nil
+}
+
+// Replaces the enums among the attributes of nodes with their JSON values.
+func flatten(nodes []*jsonapi.Node) error {
+	for _, n := range nodes {
+		if n == nil {
+			continue
+		}
+		for k, v := range n.Attributes {
+			if v == nil || !isEnum(reflect.TypeOf(v)) {
+				continue
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				return &enum.FieldError{Field: k, Err: err}
+			}
+			n.Attributes[k] = json.RawMessage(b)
+		}
+	}
+	return nil
+}
+
+// The struct types of the models reachable from t through its relations, by their JSON:API
+// resource type.
+func modelTypes(t reflect.Type, out map[string]reflect.Type) map[string]reflect.Type {
+	if out == nil {
+		out = make(map[string]reflect.Type)
+	}
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return out
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if args := tagArgs(t.Field(i)); len(args) > 1 && args[0] == "primary" {
+			if _, ok := out[args[1]]; ok {
+				return out
+			}
+			out[args[1]] = t
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if args := tagArgs(t.Field(i)); len(args) > 1 && args[0] == "relation" {
+			modelTypes(t.Field(i).Type, out)
+		}
+	}
+	return out
+}
+
+// Removes the enum attributes from nodes so jsonapi doesn't try to decode them, returning
+// those of each node in turn.
+func strip(types map[string]reflect.Type, nodes []*jsonapi.Node) []map[string]interface{} {
+	out := make([]map[string]interface{}, len(nodes))
+	for i, n := range nodes {
+		if n == nil {
+			continue
+		}
+		t, ok := types[n.Type]
+		if !ok {
+			continue
+		}
+		out[i] = make(map[string]interface{})
+		for j := 0; j < t.NumField(); j++ {
+			args := tagArgs(t.Field(j))
+			if len(args) < 2 || args[0] != "attr" || !isEnum(t.Field(j).Type) {
+				continue
+			}
+			if v, ok := n.Attributes[args[1]]; ok {
+				out[i][args[1]] = v
+				delete(n.Attributes, args[1])
+			}
+		}
+	}
+	return out
+}
+
+// Strips the enum attributes of the included nodes, keyed by their type and ID.
+func stripIncluded(types map[string]reflect.Type, nodes []*jsonapi.Node) map[string]map[string]interface{} {
+	out := make(map[string]map[string]interface{})
+	for i, attrs := range strip(types, nodes) {
+		if attrs != nil {
+			out[nodes[i].Type+","+nodes[i].ID] = attrs
+		}
+	}
+	return out
+}
+
+// Sets the enum attributes stripped from a node on the model v decoded from it.
+func setAttrs(v reflect.Value, attrs map[string]interface{}) error {
+	v = reflect.Indirect(v)
+	if len(attrs) == 0 || v.Kind() != reflect.Struct {
+		return nil
+	}
+	for i := 0; i < v.NumField(); i++ {
+		args := tagArgs(v.Type().Field(i))
+		if len(args) < 2 || args[0] != "attr" {
+			continue
+		}
+		raw, ok := attrs[args[1]]
+		if !ok {
+			continue
+		}
+		f := v.Field(i)
+		if f.Kind() == reflect.Ptr {
+			if raw == nil {
+				continue
+			}
+			if f.IsNil() {
+				f.Set(reflect.New(f.Type().Elem()))
+			}
+			f = f.Elem()
+		}
+		e := f.Addr().Interface().(enum.Enummer)
+		if _, err := enum.NewE(e); err != nil {
+			return &enum.FieldError{Field: args[1], Err: err}
+		}
+		b, err := json.Marshal(raw)
+		if err == nil {
+			err = json.Unmarshal(b, e)
+		}
+		if err != nil {
+			return &enum.FieldError{Field: args[1], Err: err}
+		}
+	}
+	return nil
+}
+
+// Sets the enum attributes stripped from included nodes on the models v relates to, and on
+// the models they relate to in turn.
+func setIncluded(v reflect.Value, included map[string]map[string]interface{}, seen map[reflect.Value]bool) error {
+	if v.Kind() != reflect.Ptr || v.IsNil() || seen[v] {
+		return nil
+	}
+	seen[v] = true
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	for i := 0; i < v.NumField(); i++ {
+		args := tagArgs(v.Type().Field(i))
+		if len(args) < 2 {
+			continue
+		}
+		switch args[0] {
+		case "primary":
+			if attrs, ok := included[args[1]+","+primaryID(v.Field(i))]; ok {
+				if err := setAttrs(v, attrs); err != nil {
+					return err
+				}
+			}
+		case "relation":
+			f := v.Field(i)
+			if f.Kind() == reflect.Slice {
+				for j := 0; j < f.Len(); j++ {
+					if err := setIncluded(f.Index(j), included, seen); err != nil {
+						return err
+					}
+				}
+			} else if err := setIncluded(f, included, seen); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// The ID of a model as it appears in a JSON:API document.
+func primaryID(f reflect.Value) string {
+	if f.Kind() == reflect.Ptr {
+		if f.IsNil() {
+			return ""
+		}
+		f = f.Elem()
+	}
+	return fmt.Sprint(f.Interface())
+}
+
+// The arguments of a field's jsonapi tag, e.g. attr and status for jsonapi:"attr,status".
+func tagArgs(f reflect.StructField) []string {
+	tag, ok := f.Tag.Lookup("jsonapi")
+	if !ok {
+		return nil
+	}
+	return strings.Split(tag, ",")
+}
+
+func isEnum(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		return t.Implements(enummerType)
+	}
+	return reflect.PtrTo(t).Implements(enummerType)
+}
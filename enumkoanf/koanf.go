@@ -0,0 +1,107 @@
+// Integrates go-enum with github.com/knadh/koanf so enums in config loaded from files, the
+// environment or flags are constructed and validated the same way wherever they came from
+//   k := koanf.New(".")
+//   _ = k.Load(enumkoanf.Defaults(&cfg), nil)
+//   _ = k.Load(file.Provider("config.yaml"), yaml.Parser())
+//
+//   err := enumkoanf.Unmarshal(k, "", &cfg) // <-- reports every invalid enum with its path
+package enumkoanf
+
+import (
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/knadh/koanf/v2"
+	"github.com/pkg/errors"
+	"go-enum"
+	"reflect"
+	"strings"
+)
+
+const readBytesErrorMsg = "enumkoanf.Defaults does not support ReadBytes"
+
+var enummerType = reflect.TypeOf((*enum.Enummer)(nil)).Elem()
+
+// The decode hook koanf uses by default with enum.DecodeHookFunc in front of it, so strings
+// are turned into constructed, validated enums
+func DecodeHook() mapstructure.DecodeHookFunc {
+	return mapstructure.ComposeDecodeHookFunc(
+		enum.DecodeHookFunc(),
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.TextUnmarshallerHookFunc(),
+	)
+}
+
+// The koanf.UnmarshalConf for decoding with DecodeHook
+//   err := k.UnmarshalWithConf("billing", &billing, enumkoanf.UnmarshalConf())
+func UnmarshalConf() koanf.UnmarshalConf {
+	return koanf.UnmarshalConf{
+		DecoderConfig: &mapstructure.DecoderConfig{
+			DecodeHook:       DecodeHook(),
+			WeaklyTypedInput: true,
+		},
+	}
+}
+
+// Unmarshals path into out with DecodeHook, then prepares and checks every enum in out with
+// enum.ValidateConfig. Enums missing from the config are given their default Const
+func Unmarshal(k *koanf.Koanf, path string, out interface{}, opts ...enum.Option) error {
+	if err := k.UnmarshalWithConf(path, out, UnmarshalConf()); err != nil {
+		return err
+	}
+	return enum.ValidateConfig(out, opts...)
+}
+
+type defaults struct {
+	cfg interface{}
+}
+
+// A koanf.Provider holding the default Const of every enum field in cfg which has one, keyed
+// by the koanf tag or field name. Load it first so files, the environment and flags override it
+func Defaults(cfg interface{}) koanf.Provider {
+	return defaults{cfg: cfg}
+}
+
+func (d defaults) ReadBytes() ([]byte, error) {
+	return nil, errors.New(readBytesErrorMsg)
+}
+
+func (d defaults) Read() (map[string]interface{}, error) {
+	out := make(map[string]interface{})
+	collect(reflect.TypeOf(d.cfg), out)
+	return out, nil
+}
+
+// Fills out with the defaults of the enum fields of the struct type t, nesting a map for
+// each struct field which isn't an enum.
+func collect(t reflect.Type, out map[string]interface{}) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("koanf"), ",")[0]
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if reflect.PtrTo(ft).Implements(enummerType) {
+			if e, err := enum.NewE(reflect.New(ft).Interface().(enum.Enummer)); err == nil && e.Get() != "" {
+				out[name] = string(e.Get())
+			}
+			continue
+		}
+		sub := make(map[string]interface{})
+		collect(ft, sub)
+		if len(sub) > 0 {
+			out[name] = sub
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Integrates go-enum with github.com/alecthomas/kong so enum typed flags and arguments are
+// validated as they are parsed and list their values in help output
+//   var cli struct {
+//     Currency CurrencyCodes `help:"the currency to charge in"`
+//   }
+//
+//   parser := kong.Must(&cli, enumkong.Option())
+//   parser.Parse(os.Args[1:]) // --currency=VALUE  the currency to charge in (one of USD, EUR)
+package enumkong
+
+import (
+	"fmt"
+	"github.com/alecthomas/kong"
+	"github.com/pkg/errors"
+	"go-enum"
+	"reflect"
+	"strings"
+)
+
+const notEnumErrorMsg = "%s is not an enum"
+
+// The var holding the values an enum flag or argument accepts, see Mapper
+const ValuesVar = "enum_values"
+
+var enummerType = reflect.TypeOf((*enum.Enummer)(nil)).Elem()
+
+// A kong.MapperValue setting its value on an enum
+type MapperValue struct {
+	e enum.Enummer
+}
+
+// Adapts the enum to kong.MapperValue
+func Value(e enum.Enummer) *MapperValue {
+	return &MapperValue{e: e}
+}
+
+// Sets the enum from the next value on the command line. Returns an error if the value is
+// invalid
+func (v *MapperValue) Decode(ctx *kong.DecodeContext) error {
+	var s string
+	if err := ctx.Scan.PopValueInto("value", &s); err != nil {
+		return err
+	}
+	return enum.Flag(v.e).Set(s)
+}
+
+// Maps command line values onto enums. It also contributes the ValuesVar var so help can refer
+// to the values an enum accepts as ${enum_values}. Register it for a single type with
+// kong.TypeMapper or for every enum with Option
+//   kong.Must(&cli, kong.TypeMapper(reflect.TypeOf(CurrencyCodes{}), enumkong.Mapper{}))
+type Mapper struct{}
+
+func (Mapper) Decode(ctx *kong.DecodeContext, target reflect.Value) error {
+	e, ok := enummer(target)
+	if !ok {
+		return errors.New(fmt.Sprintf(notEnumErrorMsg, target.Type()))
+	}
+	return Value(e).Decode(ctx)
+}
+
+func (Mapper) Vars(value *kong.Value) kong.Vars {
+	e, ok := enummer(value.Target)
+	if !ok {
+		return nil
+	}
+	return kong.Vars{ValuesVar: strings.Join(values(e), ", ")}
+}
+
+// Registers Mapper for every enum typed flag and argument in the model. Their help is followed
+// by the values they accept, leaving out deprecated and retired Consts, unless it already
+// refers to ${enum_values}
+func Option() kong.Option {
+	return kong.PostBuild(func(k *kong.Kong) error {
+		return kong.Visit(k.Model, func(node kong.Visitable, next kong.Next) error {
+			if v, ok := node.(*kong.Value); ok && isEnum(v.Target) {
+				v.Mapper = Mapper{}
+				if !strings.Contains(v.Help, "${"+ValuesVar+"}") {
+					v.Help = strings.TrimSpace(v.Help + " (one of ${" + ValuesVar + "})")
+				}
+			}
+			return next(nil)
+		})
+	})
+}
+
+func isEnum(target reflect.Value) bool {
+	if !target.IsValid() {
+		return false
+	}
+	t := target.Type()
+	if t.Kind() == reflect.Ptr {
+		return t.Implements(enummerType)
+	}
+	return reflect.PtrTo(t).Implements(enummerType)
+}
+
+// The enum held by target, allocating it first if target is a nil pointer.
+func enummer(target reflect.Value) (enum.Enummer, bool) {
+	if !isEnum(target) {
+		return nil, false
+	}
+	if target.Kind() != reflect.Ptr {
+		if !target.CanAddr() {
+			return nil, false
+		}
+		return target.Addr().Interface().(enum.Enummer), true
+	}
+	if target.IsNil() {
+		if !target.CanSet() {
+			return nil, false
+		}
+		target.Set(reflect.New(target.Type().Elem()))
+	}
+	return target.Interface().(enum.Enummer), true
+}
+
+// The Consts which can be suggested for e.
+func values(e enum.Enummer) []string {
+	d, err := enum.Describe(e)
+	if err != nil {
+		return nil
+	}
+	return d.Values()
+}
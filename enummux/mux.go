@@ -0,0 +1,20 @@
+// Integrates go-enum with github.com/gorilla/mux, reading enums from route variables
+//   r.HandleFunc("/orders/{status}", func(w http.ResponseWriter, r *http.Request) {
+//     status := enum.New(new(OrderStatus)).(*OrderStatus)
+//     if err := enummux.FromRequestPath(status, r, "status"); err != nil {
+//       http.Error(w, err.Error(), http.StatusBadRequest)
+//       return
+//     }
+//   })
+package enummux
+
+import (
+	"github.com/gorilla/mux"
+	"go-enum"
+	"net/http"
+)
+
+// Sets the enum from the mux route variable param of r, see enum.FromRequestPath
+func FromRequestPath(e enum.Enummer, r *http.Request, param string, opts ...enum.Option) error {
+	return enum.FromParam(e, param, mux.Vars(r)[param], opts...)
+}
@@ -0,0 +1,208 @@
+// Integrates go-enum with github.com/jackc/pgx/v5 so enums map onto native Postgres enum types
+//   // CREATE TYPE currency_code AS ENUM ('USD', 'EUR');
+//   err := enumpgx.Register(ctx, conn, "currency_code", new(CurrencyCodes))
+//
+//   var cc CurrencyCodes
+//   err = conn.QueryRow(ctx, "SELECT currency FROM payments").Scan(&cc)
+package enumpgx
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pkg/errors"
+	"go-enum"
+	"reflect"
+	"strconv"
+)
+
+const scanNullErrorMsg = "cannot scan NULL into %T"
+
+// Loads the Postgres enum type called name, along with its array type, and registers them on
+// conn to be encoded and scanned through a Codec for e
+func Register(ctx context.Context, conn *pgx.Conn, name string, e enum.Enummer) error {
+	codec, err := NewCodec(e)
+	if err != nil {
+		return err
+	}
+	t, err := conn.LoadType(ctx, name)
+	if err != nil {
+		return err
+	}
+	m := conn.TypeMap()
+	m.RegisterType(&pgtype.Type{Name: t.Name, OID: t.OID, Codec: codec})
+	m.RegisterDefaultPgType(e, name)
+	m.RegisterDefaultPgType(reflect.ValueOf(e).Elem().Interface(), name)
+
+	arr, err := conn.LoadType(ctx, "_"+name)
+	if err != nil {
+		return err
+	}
+	m.RegisterType(arr)
+	return nil
+}
+
+// A pgtype.Codec for a Postgres enum type holding the values of an enum. Labels are checked
+// against the enum in both directions so invalid ones fail before reaching the database or
+// the caller. Retired labels can be scanned but not encoded. The text and binary formats of
+// a Postgres enum are both the label itself so neither needs converting. NOTE: pgx sends
+// plain strings in the text format without consulting the codec, so pass Consts or enums
+// to have them checked
+type Codec struct {
+	desc *enum.Descriptor
+}
+
+// Creates a Codec for the type of e. Returns an error if the enum is declared incorrectly
+func NewCodec(e enum.Enummer) (*Codec, error) {
+	d, err := enum.Describe(e)
+	if err != nil {
+		return nil, err
+	}
+	return &Codec{desc: d}, nil
+}
+
+func (c *Codec) FormatSupported(format int16) bool {
+	return format == pgtype.TextFormatCode || format == pgtype.BinaryFormatCode
+}
+
+func (c *Codec) PreferredFormat() int16 {
+	return pgtype.BinaryFormatCode
+}
+
+func (c *Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value interface{}) pgtype.EncodePlan {
+	if !c.FormatSupported(format) || value == nil {
+		return nil
+	}
+	switch value.(type) {
+	case string, enum.Const, enum.Enummer:
+		return encodePlan{codec: c}
+	}
+	if _, ok := reflect.New(reflect.TypeOf(value)).Interface().(enum.Enummer); ok {
+		return encodePlan{codec: c}
+	}
+	return nil
+}
+
+func (c *Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target interface{}) pgtype.ScanPlan {
+	if !c.FormatSupported(format) {
+		return nil
+	}
+	switch target.(type) {
+	case *string, *enum.Const, enum.Enummer:
+		return scanPlan{codec: c}
+	}
+	if t := reflect.TypeOf(target); t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Ptr {
+		if _, ok := reflect.New(t.Elem().Elem()).Interface().(enum.Enummer); ok {
+			return scanPlan{codec: c}
+		}
+	}
+	return nil
+}
+
+func (c *Codec) DecodeDatabaseSQLValue(m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
+	return c.DecodeValue(m, oid, format, src)
+}
+
+func (c *Codec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (interface{}, error) {
+	if src == nil {
+		return nil, nil
+	}
+	label, err := c.scan(src)
+	if err != nil {
+		return nil, err
+	}
+	return string(label), nil
+}
+
+// Checks the label read from the database, allowing retired labels.
+func (c *Codec) scan(src []byte) (enum.Const, error) {
+	label := enum.Const(src)
+	if _, ok := c.desc.Lookup(label); !ok {
+		return "", &enum.InvalidEnumError{Type: c.desc.Name(), Value: label}
+	}
+	return label, nil
+}
+
+// Checks the label about to be written to the database, rejecting retired labels. An enum
+// without a value is written as NULL.
+func (c *Codec) encode(label enum.Const) error {
+	cd, ok := c.desc.Lookup(label)
+	if !ok || cd.Retired != "" {
+		return &enum.InvalidEnumError{Type: c.desc.Name(), Value: label, Retired: ok}
+	}
+	return nil
+}
+
+type encodePlan struct {
+	codec *Codec
+}
+
+func (p encodePlan) Encode(value interface{}, buf []byte) ([]byte, error) {
+	var label enum.Const
+	switch v := value.(type) {
+	case string:
+		label = enum.Const(v)
+	case enum.Const:
+		label = v
+	case enum.Enummer:
+		label = v.Get()
+	default:
+		e := reflect.New(reflect.TypeOf(value))
+		e.Elem().Set(reflect.ValueOf(value))
+		label = e.Interface().(enum.Enummer).Get()
+	}
+	if label == "" {
+		return nil, nil
+	}
+	if err := p.codec.encode(label); err != nil {
+		return nil, err
+	}
+	return append(buf, label...), nil
+}
+
+type scanPlan struct {
+	codec *Codec
+}
+
+func (p scanPlan) Scan(src []byte, target interface{}) error {
+	if src == nil {
+		if t := reflect.ValueOf(target); t.Elem().Kind() == reflect.Ptr {
+			t.Elem().Set(reflect.Zero(t.Elem().Type()))
+			return nil
+		}
+		return errors.New(fmt.Sprintf(scanNullErrorMsg, target))
+	}
+	label, err := p.codec.scan(src)
+	if err != nil {
+		return err
+	}
+	switch t := target.(type) {
+	case *string:
+		*t = string(label)
+		return nil
+	case *enum.Const:
+		*t = label
+		return nil
+	case enum.Enummer:
+		return load(t, label)
+	}
+	v := reflect.ValueOf(target).Elem()
+	e := reflect.New(v.Type().Elem())
+	if err := load(e.Interface().(enum.Enummer), label); err != nil {
+		return err
+	}
+	v.Set(e)
+	return nil
+}
+
+// Stores the label on e without the checks Set makes, as rows may hold retired values or
+// ones the enum's transitions wouldn't allow it to move to.
+func load(e enum.Enummer, label enum.Const) error {
+	if err := json.Unmarshal([]byte(strconv.Quote(string(label))), e); err != nil {
+		return err
+	}
+	return enum.Validate(e, enum.Lenient())
+}
@@ -0,0 +1,50 @@
+// Integrates go-enum with github.com/prometheus/client_golang so a label is only ever set to
+// one of an enum's values, keeping its cardinality bounded
+//   byStatus, err := enumprom.CurryByEnum(ordersTotal, "status", new(OrderStatus))
+//   byStatus[status.Get()].WithLabelValues("eu-west-1").Inc()
+package enumprom
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go-enum"
+)
+
+// A metric vector which can be curried, such as *prometheus.CounterVec, *prometheus.GaugeVec
+// or prometheus.ObserverVec for histograms and summaries
+type Curryer[V any] interface {
+	CurryWith(prometheus.Labels) (V, error)
+}
+
+// Curries vec with label set to each of the enum's values, see enum.LabelValues. When label is
+// the only label on vec the child for each value is created as well, so every value is
+// exported from the start and dashboards can list them all before they are first seen.
+// Returns an error if vec has no label called label
+//   ordersTotal := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "orders_total"}, []string{"status"})
+//   byStatus, err := enumprom.CurryByEnum(ordersTotal, "status", new(OrderStatus))
+//   byStatus[order.Status.Get()].WithLabelValues().Inc()
+func CurryByEnum[V Curryer[V]](vec V, label string, e enum.Enummer) (map[enum.Const]V, error) {
+	values := enum.LabelValues(e)
+	out := make(map[enum.Const]V, len(values))
+	for _, v := range values {
+		curried, err := vec.CurryWith(prometheus.Labels{label: v})
+		if err != nil {
+			return nil, err
+		}
+		preregister(curried)
+		out[enum.Const(v)] = curried
+	}
+	return out, nil
+}
+
+// Creates the child of a vector with every label curried. Vectors with labels left are
+// skipped since their children can't be created without them.
+func preregister(vec interface{}) {
+	switch v := vec.(type) {
+	case *prometheus.CounterVec:
+		_, _ = v.GetMetricWith(nil)
+	case *prometheus.GaugeVec:
+		_, _ = v.GetMetricWith(nil)
+	case prometheus.ObserverVec:
+		_, _ = v.GetMetricWith(nil)
+	}
+}
@@ -0,0 +1,128 @@
+// Generates go-enum structs from the enums in .proto files, so proto can stay the source of
+// truth. Run it through protoc-gen-goenum alongside protoc-gen-go
+//   go install go-enum/cmd/protoc-gen-goenum
+//   protoc --go_out=. --goenum_out=. status.proto
+package enumproto
+
+import (
+	"fmt"
+	"go-enum"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+	"strings"
+	"unicode"
+)
+
+// The suffix added to each proto enum's Go name to name its struct, which keeps it apart from
+// the type protoc-gen-go generates
+const Suffix = "Enum"
+
+const enumPackage = protogen.GoImportPath("go-enum")
+
+// Writes a .goenum.go file next to each generated .proto file holding enums. Every proto enum
+// becomes a struct with a Const per value. The Const's value is the proto name, its code the
+// proto number and values marked deprecated are tagged deprecated. Values sharing a number
+// through allow_alias become aliases of the first. Field names drop the enum's prefix
+//   enum Status {
+//     STATUS_UNSPECIFIED = 0;
+//     STATUS_ACTIVE = 1;
+//   }
+//
+//   type StatusEnum struct {
+//     enum.Enum
+//     Unspecified enum.Const `enum:"STATUS_UNSPECIFIED" code:"0"`
+//     Active      enum.Const `enum:"STATUS_ACTIVE" code:"1"`
+//   }
+func Generate(gen *protogen.Plugin) error {
+	gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+	for _, f := range gen.Files {
+		if !f.Generate {
+			continue
+		}
+		enums := collectEnums(f.Enums, f.Messages)
+		if len(enums) == 0 {
+			continue
+		}
+		g := gen.NewGeneratedFile(f.GeneratedFilenamePrefix+".goenum.go", f.GoImportPath)
+		g.P("// Code generated by protoc-gen-goenum. DO NOT EDIT.")
+		g.P("// source: ", f.Desc.Path())
+		g.P()
+		g.P("package ", f.GoPackageName)
+		g.P()
+		g.P("import enum ", enumPackage)
+		for _, e := range enums {
+			generateEnum(g, e)
+		}
+	}
+	return nil
+}
+
+// The enums declared at the top level and within messages, in the order they are declared.
+func collectEnums(enums []*protogen.Enum, messages []*protogen.Message) []*protogen.Enum {
+	out := append([]*protogen.Enum(nil), enums...)
+	for _, m := range messages {
+		out = append(out, collectEnums(m.Enums, m.Messages)...)
+	}
+	return out
+}
+
+// A Const field generated for a proto enum value.
+type protoConst struct {
+	field    string
+	comments protogen.Comments
+	value    string
+	code     int32
+	extra    string
+}
+
+func generateEnum(g *protogen.GeneratedFile, e *protogen.Enum) {
+	prefix := valuePrefix(string(e.Desc.Name()))
+	var consts []*protoConst
+	byCode := make(map[int32]*protoConst)
+	for _, v := range e.Values {
+		name := string(v.Desc.Name())
+		code := int32(v.Desc.Number())
+		if c, ok := byCode[code]; ok {
+			c.value += ",alias=" + name
+			continue
+		}
+		c := &protoConst{field: fieldName(name, prefix), comments: v.Comments.Leading, value: name, code: code}
+		if opts, ok := v.Desc.Options().(*descriptorpb.EnumValueOptions); ok && opts.GetDeprecated() {
+			c.extra = ` deprecated:""`
+		}
+		byCode[code] = c
+		consts = append(consts, c)
+	}
+
+	g.P()
+	g.P(e.Comments.Leading.String(), "type ", e.GoIdent.GoName, Suffix, " struct {")
+	g.P("enum.Enum")
+	for _, c := range consts {
+		g.P(c.comments.String(), c.field, " enum.Const",
+			fmt.Sprintf(" `enum:%q code:\"%d\"%s`", c.value, c.code, c.extra))
+	}
+	g.P("}")
+}
+
+// The prefix proto style puts on each value of the enum called name, such as "ORDER_STATUS_"
+// for OrderStatus.
+func valuePrefix(name string) string {
+	snake, _ := enum.SnakeCase.Apply(name)
+	return strings.ToUpper(snake) + "_"
+}
+
+// The Go field name for the value called name, with the enum's prefix dropped when what's left
+// still makes an identifier.
+func fieldName(name, prefix string) string {
+	if rest := strings.TrimPrefix(name, prefix); rest != name && rest != "" && !unicode.IsDigit(rune(rest[0])) {
+		name = rest
+	}
+	var b strings.Builder
+	for _, w := range strings.Split(strings.ToLower(name), "_") {
+		if w != "" {
+			b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		}
+	}
+	return b.String()
+}
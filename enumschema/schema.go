@@ -0,0 +1,23 @@
+// Integrates go-enum with github.com/gorilla/schema so enums in decoded forms are validated.
+// Enums are read through UnmarshalText so no converters need registering
+//   var req CreateOrder
+//   if err := enumschema.Decode(decoder, &req, r.PostForm); err != nil {
+//     http.Error(w, err.Error(), http.StatusBadRequest)
+//     return
+//   }
+package enumschema
+
+import (
+	"github.com/gorilla/schema"
+	"go-enum"
+)
+
+// Decodes src into dst with d, then validates every enum in dst with enum.ValidateAll.
+// Decoding errors are returned as they are, invalid enums as an *enum.MultiError naming the
+// path of each offending field
+func Decode(d *schema.Decoder, dst interface{}, src map[string][]string, opts ...enum.Option) error {
+	if err := d.Decode(dst, src); err != nil {
+		return err
+	}
+	return enum.ValidateAll(dst, opts...)
+}
@@ -0,0 +1,37 @@
+// Integrates go-enum's translations with golang.org/x/text so the best registered language
+// is picked for a request rather than only exact and parent tags
+//   enum.RegisterTranslations(new(CurrencyCodes), "fr", map[enum.Const]string{"USD": "Dollar américain"})
+//   fmt.Println(enumtext.LocalizeAccept(cc, r.Header.Get("Accept-Language")))
+package enumtext
+
+import (
+	"go-enum"
+	"golang.org/x/text/language"
+)
+
+// Renders the enum's current value in the registered language that best matches the
+// preferred tags. Falls back to the enum's DisplayName when nothing matches
+func Localize(e enum.Enummer, preferred ...language.Tag) string {
+	langs := enum.Languages(e)
+	if len(langs) == 0 {
+		return enum.Localize(e, "")
+	}
+	supported := make([]language.Tag, 0, len(langs)+1)
+	// The first supported tag is what the matcher falls back to when nothing is close.
+	supported = append(supported, language.Und)
+	for _, l := range langs {
+		supported = append(supported, language.Make(l))
+	}
+	_, i, conf := language.NewMatcher(supported).Match(preferred...)
+	if conf == language.No || i == 0 {
+		return enum.Localize(e, "")
+	}
+	return enum.Localize(e, langs[i-1])
+}
+
+// Same as Localize but takes an Accept-Language header
+//   enumtext.LocalizeAccept(cc, "fr-CH, fr;q=0.9, en;q=0.8")
+func LocalizeAccept(e enum.Enummer, accept string) string {
+	tags, _, _ := language.ParseAcceptLanguage(accept)
+	return Localize(e, tags...)
+}
@@ -0,0 +1,74 @@
+// Integrates go-enum with github.com/go-playground/validator so enums are validated
+// alongside every other field
+//   v := validator.New()
+//   enumvalidator.RegisterValidation(v, Money{})
+//
+//   type Money struct {
+//     CurrencyCode CurrencyCodes `json:"currency_code" validate:"enum"`
+//     Amount       int           `json:"amount"`
+//   }
+package enumvalidator
+
+import (
+	"github.com/go-playground/validator/v10"
+	"go-enum"
+	"reflect"
+)
+
+// The validation tag registered by RegisterValidation
+const Tag = "enum"
+
+var enummerType = reflect.TypeOf((*enum.Enummer)(nil)).Elem()
+
+// Registers the enum tag on v. Any struct types provided also get a struct level validation
+// which checks every enum field on the struct without the need for a tag
+func RegisterValidation(v *validator.Validate, types ...interface{}) error {
+	if err := v.RegisterValidation(Tag, validateField); err != nil {
+		return err
+	}
+	if len(types) > 0 {
+		v.RegisterStructValidation(validateStruct, types...)
+	}
+	return nil
+}
+
+func validateField(fl validator.FieldLevel) bool {
+	e, ok := enummer(fl.Field())
+	return ok && enum.Validate(e) == nil
+}
+
+func validateStruct(sl validator.StructLevel) {
+	v := sl.Current()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		e, ok := enummer(v.Field(i))
+		if !ok {
+			continue
+		}
+		if err := enum.Validate(e); err != nil {
+			sl.ReportError(v.Field(i).Interface(), f.Name, f.Name, Tag, string(e.Get()))
+		}
+	}
+}
+
+// Gets the enum held by v. Values that aren't addressable are copied so they can be validated.
+func enummer(v reflect.Value) (enum.Enummer, bool) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	if !v.CanAddr() {
+		cp := reflect.New(v.Type()).Elem()
+		cp.Set(v)
+		v = cp
+	}
+	if !v.Addr().Type().Implements(enummerType) {
+		return nil, false
+	}
+	return v.Addr().Interface().(enum.Enummer), true
+}
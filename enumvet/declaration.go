@@ -0,0 +1,100 @@
+package enumvet
+
+import (
+	"go-enum"
+	"go/ast"
+	"go/types"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"reflect"
+)
+
+// Reports enum structs which are declared incorrectly. Mistakes such as two Consts with the
+// same value are otherwise only caught at runtime, if at all
+//   type CurrencyCodes struct {
+//     USD enum.Const
+//     enum.Enum        // <-- enum.Enum should be the first field of CurrencyCodes
+//     Euro enum.Const `enum:"USD"` // <-- USD is also the value of USD
+//     EUR  string     // <-- EUR has type string rather than enum.Const
+//   }
+var Declaration = &analysis.Analyzer{
+	Name:     "enumdecl",
+	Doc:      "check that enum structs are declared correctly",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      runDeclaration,
+}
+
+func runDeclaration(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.TypeSpec)(nil)}, func(n ast.Node) {
+		spec := n.(*ast.TypeSpec)
+		obj := pass.TypesInfo.Defs[spec.Name]
+		if obj == nil {
+			return
+		}
+		named, s, ok := enumStruct(obj.Type())
+		if !ok || named.Obj() != obj {
+			return
+		}
+		if f := s.Field(0); !f.Embedded() || !isEnumType(f.Type(), "Enum") {
+			pass.Reportf(f.Pos(), "enum.Enum should be the first field of %s", named.Obj().Name())
+		}
+
+		naming := enum.Case(typeTag(s).Get("case"))
+		values := make(map[string]string)
+		for i := 0; i < s.NumFields(); i++ {
+			f := s.Field(i)
+			tag := reflect.StructTag(s.Tag(i))
+			if f.Embedded() || ignored(f, tag) {
+				continue
+			}
+			if !isConstType(f.Type()) {
+				if !looksLikeConst(f, tag) {
+					continue
+				}
+				typ := types.TypeString(f.Type(), types.RelativeTo(pass.Pkg))
+				if _, ok := f.Type().(*types.Named); ok && isString(f.Type()) {
+					pass.Reportf(f.Pos(), "%s has type %s which lacks the EnumConst method of enum.ConstMarker", f.Name(), typ)
+				} else {
+					pass.Reportf(f.Pos(), "%s has type %s rather than enum.Const", f.Name(), typ)
+				}
+				continue
+			}
+			if value, ok := tag.Lookup("enum"); ok && value == "" {
+				pass.Reportf(f.Pos(), "enum tag on %s is empty, remove it to use the field name", f.Name())
+			}
+			value := tagValue(tag)
+			if value == "" {
+				var ok bool
+				if value, ok = naming.Apply(f.Name()); !ok {
+					continue
+				}
+			}
+			if other, ok := values[value]; ok {
+				pass.Reportf(f.Pos(), "%s is also the value of %s", value, other)
+				continue
+			}
+			values[value] = f.Name()
+		}
+	})
+	return nil, nil
+}
+
+// Whether a field which doesn't declare a Const was most likely meant to, being exported and
+// either a plain string or carrying an enum tag.
+func looksLikeConst(f *types.Var, tag reflect.StructTag) bool {
+	if !f.Exported() {
+		return false
+	}
+	if _, ok := tag.Lookup("enum"); ok {
+		return true
+	}
+	return isString(f.Type())
+}
+
+// Whether the underlying type of t is string.
+func isString(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Kind() == types.String
+}
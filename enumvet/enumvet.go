@@ -0,0 +1,147 @@
+// Static analyzers for go-enum, catching mistakes the compiler can't. Run them through go vet
+//   go install go-enum/cmd/enumvet
+//   go vet -vettool=$(which enumvet) ./...
+package enumvet
+
+import (
+	"go-enum"
+	"go/types"
+	"golang.org/x/tools/go/analysis"
+	"reflect"
+	"strings"
+)
+
+// Every analyzer in the package
+var Analyzers = []*analysis.Analyzer{Declaration, Exhaustive}
+
+// A Const field declared on an enum struct.
+type constField struct {
+	field *types.Var
+	value string
+}
+
+// Whether p is the go-enum package.
+func isEnumPkg(p *types.Package) bool {
+	return p != nil && (p.Path() == "go-enum" || strings.HasSuffix(p.Path(), "/go-enum"))
+}
+
+// Whether t is the named type called name from the go-enum package.
+func isEnumType(t types.Type, name string) bool {
+	n, ok := t.(*types.Named)
+	return ok && n.Obj().Name() == name && isEnumPkg(n.Obj().Pkg())
+}
+
+// Whether fields of type t declare Consts, mirroring construct. That is enum.Const or a named
+// string type with an EnumConst method, such as type CurrencyConst enum.Const, see
+// enum.ConstMarker.
+func isConstType(t types.Type) bool {
+	if isEnumType(t, "Const") {
+		return true
+	}
+	n, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	if b, ok := n.Underlying().(*types.Basic); !ok || b.Kind() != types.String {
+		return false
+	}
+	m, _, _ := types.LookupFieldOrMethod(n, false, n.Obj().Pkg(), "EnumConst")
+	f, ok := m.(*types.Func)
+	if !ok {
+		return false
+	}
+	sig := f.Type().(*types.Signature)
+	return sig.Params().Len() == 0 && sig.Results().Len() == 0
+}
+
+// The struct behind t, or behind what t points to, if it embeds enum.Enum.
+func enumStruct(t types.Type) (*types.Named, *types.Struct, bool) {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	n, ok := t.(*types.Named)
+	if !ok {
+		return nil, nil, false
+	}
+	s, ok := n.Underlying().(*types.Struct)
+	if !ok {
+		return nil, nil, false
+	}
+	for i := 0; i < s.NumFields(); i++ {
+		if f := s.Field(i); f.Embedded() && isEnumType(f.Type(), "Enum") {
+			return n, s, true
+		}
+	}
+	return nil, nil, false
+}
+
+// The tag on the enum.Enum embedded in s.
+func typeTag(s *types.Struct) reflect.StructTag {
+	for i := 0; i < s.NumFields(); i++ {
+		if f := s.Field(i); f.Embedded() && isEnumType(f.Type(), "Enum") {
+			return reflect.StructTag(s.Tag(i))
+		}
+	}
+	return ""
+}
+
+// The Const fields of s along with their values, including those of embedded enum structs.
+// Retired Consts are left out since they can't be set.
+func constFields(s *types.Struct) []constField {
+	var out []constField
+	naming := enum.Case(typeTag(s).Get("case"))
+	if _, ok := typeTag(s).Lookup("case"); !ok && typeTag(s).Get("format") == "proto" {
+		naming = enum.ScreamingSnakeCase
+	}
+	for i := 0; i < s.NumFields(); i++ {
+		f := s.Field(i)
+		tag := reflect.StructTag(s.Tag(i))
+		if ignored(f, tag) {
+			continue
+		}
+		if f.Embedded() {
+			if _, sub, ok := enumStruct(f.Type()); ok && !isEnumType(f.Type(), "Enum") {
+				out = append(out, constFields(sub)...)
+			}
+			continue
+		}
+		if !isConstType(f.Type()) {
+			continue
+		}
+		if retired(tag) {
+			continue
+		}
+		value := tagValue(tag)
+		if value == "" {
+			var ok bool
+			if value, ok = naming.Apply(f.Name()); !ok {
+				continue
+			}
+		}
+		out = append(out, constField{field: f, value: value})
+	}
+	return out
+}
+
+// Whether construct leaves the field alone, being unexported or tagged enum:"-".
+func ignored(f *types.Var, tag reflect.StructTag) bool {
+	return !f.Exported() || tag.Get("enum") == "-"
+}
+
+// The value in a field's enum tag, leaving out any options.
+func tagValue(tag reflect.StructTag) string {
+	return strings.Split(tag.Get("enum"), ",")[0]
+}
+
+// Whether the field is tagged retired, either with its own tag or as an enum tag option.
+func retired(tag reflect.StructTag) bool {
+	if _, ok := tag.Lookup("retired"); ok {
+		return true
+	}
+	for _, opt := range strings.Split(tag.Get("enum"), ",")[1:] {
+		if k, _, _ := strings.Cut(opt, "="); strings.TrimSpace(k) == "retired" {
+			return true
+		}
+	}
+	return false
+}
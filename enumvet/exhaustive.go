@@ -0,0 +1,107 @@
+package enumvet
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"strings"
+)
+
+// Reports switches on an enum's Get which don't have a case for every Const declared on the
+// enum struct. Cases may be the enum's Const fields or string constants. A default case
+// doesn't make a switch exhaustive unless the -default flag is set
+//   switch cc.Get() { // <-- missing cases for CurrencyCodes: CUSTOM
+//   case cc.USD:
+//   }
+var Exhaustive = &analysis.Analyzer{
+	Name:     "enumexhaustive",
+	Doc:      "check that switches on an enum's value handle every Const",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      runExhaustive,
+}
+
+var defaultExhaustive bool
+
+func init() {
+	Exhaustive.Flags.BoolVar(&defaultExhaustive, "default", false, "treat switches with a default case as exhaustive")
+}
+
+func runExhaustive(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.SwitchStmt)(nil)}, func(n ast.Node) {
+		sw := n.(*ast.SwitchStmt)
+		call, ok := sw.Tag.(*ast.CallExpr)
+		if !ok {
+			return
+		}
+		// Switches on a named Const type convert the value first, as in CurrencyConst(cc.Get())
+		if tv, ok := pass.TypesInfo.Types[call.Fun]; ok && tv.IsType() && len(call.Args) == 1 {
+			if call, ok = call.Args[0].(*ast.CallExpr); !ok {
+				return
+			}
+		}
+		if len(call.Args) != 0 {
+			return
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Get" {
+			return
+		}
+		named, s, ok := enumStruct(pass.TypesInfo.TypeOf(sel.X))
+		if !ok {
+			return
+		}
+
+		fields := constFields(s)
+		covered := make(map[string]bool)
+		for _, stmt := range sw.Body.List {
+			clause := stmt.(*ast.CaseClause)
+			if clause.List == nil && defaultExhaustive {
+				return
+			}
+			for _, expr := range clause.List {
+				if v, ok := caseValue(pass, expr, fields); ok {
+					covered[v] = true
+				}
+			}
+		}
+
+		var missing []string
+		seen := make(map[string]bool)
+		for _, f := range fields {
+			if !covered[f.value] && !seen[f.value] {
+				seen[f.value] = true
+				missing = append(missing, f.value)
+			}
+		}
+		if len(missing) > 0 {
+			pass.Reportf(sw.Pos(), "missing cases for %s: %s", named.Obj().Name(), strings.Join(missing, ", "))
+		}
+	})
+	return nil, nil
+}
+
+// The value a case expression matches, either one of the enum's Const fields or a string
+// constant.
+func caseValue(pass *analysis.Pass, expr ast.Expr, fields []constField) (string, bool) {
+	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		return constant.StringVal(tv.Value), true
+	}
+	sel, ok := ast.Unparen(expr).(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	obj, ok := pass.TypesInfo.ObjectOf(sel.Sel).(*types.Var)
+	if !ok {
+		return "", false
+	}
+	for _, f := range fields {
+		if f.field == obj {
+			return f.value, true
+		}
+	}
+	return "", false
+}
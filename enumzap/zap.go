@@ -0,0 +1,38 @@
+// Integrates go-enum with go.uber.org/zap so enums are logged as their value rather than
+// reflected on as structs
+//   logger.Info("payment received", enumzap.Field("currency", cc))      // "currency":"USD"
+//   logger.Info("payment received", enumzap.TypedField("currency", cc)) // "currency":{"value":"USD","type":"CurrencyCodes"}
+package enumzap
+
+import (
+	"go-enum"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type marshaler struct {
+	e enum.Enummer
+}
+
+// A field holding the enum's value as a string
+func Field(key string, e enum.Enummer) zap.Field {
+	return zap.String(key, string(e.Get()))
+}
+
+// A field holding the enum's value along with the name of its type
+func TypedField(key string, e enum.Enummer) zap.Field {
+	return zap.Object(key, Marshaler(e))
+}
+
+// Marshals the enum as an object holding its value and the name of its type
+func Marshaler(e enum.Enummer) zapcore.ObjectMarshaler {
+	return marshaler{e: e}
+}
+
+func (m marshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("value", string(m.e.Get()))
+	if d, err := enum.Describe(m.e); err == nil {
+		enc.AddString("type", d.Name())
+	}
+	return nil
+}
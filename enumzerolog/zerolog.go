@@ -0,0 +1,36 @@
+// Integrates go-enum with github.com/rs/zerolog so enums are logged as their value rather
+// than reflected on as structs
+//   enumzerolog.Field(log.Info(), "currency", cc).Msg("payment received")      // "currency":"USD"
+//   enumzerolog.TypedField(log.Info(), "currency", cc).Msg("payment received") // "currency":{"value":"USD","type":"CurrencyCodes"}
+package enumzerolog
+
+import (
+	"github.com/rs/zerolog"
+	"go-enum"
+)
+
+type marshaler struct {
+	e enum.Enummer
+}
+
+// Adds the enum's value to ev as a string
+func Field(ev *zerolog.Event, key string, e enum.Enummer) *zerolog.Event {
+	return ev.Str(key, string(e.Get()))
+}
+
+// Adds the enum's value to ev along with the name of its type
+func TypedField(ev *zerolog.Event, key string, e enum.Enummer) *zerolog.Event {
+	return ev.Object(key, Marshaler(e))
+}
+
+// Marshals the enum as an object holding its value and the name of its type
+func Marshaler(e enum.Enummer) zerolog.LogObjectMarshaler {
+	return marshaler{e: e}
+}
+
+func (m marshaler) MarshalZerologObject(ev *zerolog.Event) {
+	ev.Str("value", string(m.e.Get()))
+	if d, err := enum.Describe(m.e); err == nil {
+		ev.Str("type", d.Name())
+	}
+}
@@ -0,0 +1,54 @@
+package enum
+
+import (
+	"os"
+	"reflect"
+	"strings"
+)
+
+// Sets the enum from the environment variable key. Matching follows the provided options
+// so, for example, enum.CaseInsensitive() accepts "usd" for USD. If the variable isn't set
+// the enum is left untouched. Errors name the variable that caused them
+//   cc := enum.New(new(CurrencyCodes)).(*CurrencyCodes)
+//   err := enum.FromEnv(cc, "CURRENCY", enum.CaseInsensitive())
+func FromEnv(e Enummer, key string, opts ...Option) error {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		return nil
+	}
+	c, err := Parse(e, s, opts...)
+	if err == nil {
+		err = e.Set(c)
+	}
+	if err != nil {
+		return &FieldError{Field: key, Err: err}
+	}
+	return nil
+}
+
+// Fills every enum within cfg from the environment. Each variable is named after the path to
+// its field, in SCREAMING_SNAKE_CASE and joined by underscores, behind the prefix. Use the
+// env tag to name a field differently or "-" to skip it
+//   type Config struct {
+//     Billing struct {
+//       CurrencyCode CurrencyCodes                  // Read from APP_BILLING_CURRENCY_CODE
+//       Fallback     CurrencyCodes `env:"FALLBACK"` // Read from APP_BILLING_FALLBACK
+//     }
+//   }
+//
+//   err := enum.LoadEnv(&cfg, "APP")
+func LoadEnv(cfg interface{}, prefix string, opts ...Option) error {
+	return walk(reflect.ValueOf(cfg), prefix, envName, func(path string, e Enummer) error {
+		if strings.Contains(path, "[") {
+			return nil
+		}
+		return FromEnv(e, strings.ReplaceAll(path, ".", "_"), opts...)
+	})
+}
+
+func envName(f reflect.StructField) string {
+	if n := strings.Split(f.Tag.Get("env"), ",")[0]; n != "" {
+		return n
+	}
+	return screamingSnake(f.Name)
+}
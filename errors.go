@@ -0,0 +1,143 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Returned when a value can't be stored on an enum, either because it isn't one of the
+// enum's Consts or because it has been retired
+type InvalidEnumError struct {
+	// The name of the enum type, empty if the enum was never constructed
+	Type  string
+	Value Const
+	// The path of the field holding the enum. Only set by InvalidValuesIn
+	Field   string
+	Retired bool
+	// The valid Consts closest to Value, in case it was mistyped
+	Suggestions []Const
+}
+
+func (i *InvalidEnumError) Error() string {
+	if i.Retired {
+		return fmt.Sprintf(retiredEnumErrorMsg, i.Value)
+	}
+	if len(i.Suggestions) > 0 {
+		return fmt.Sprintf(suggestionErrorMsg, i.Value, orList(i.Suggestions))
+	}
+	return fmt.Sprintf(invalidEnumErrorMsg, i.Value)
+}
+
+// A collection of errors, one per invalid enum, returned by ValidateAll
+type MultiError struct {
+	Errors []error
+}
+
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (m *MultiError) Unwrap() []error {
+	return m.Errors
+}
+
+// Extracts every InvalidEnumError from err, following wrapped errors as well as errors
+// joined together through an Unwrap() []error method. The Field of each is filled in
+// from any FieldError wrapping it
+//   if err := enum.DecodeStrict(r, &money); err != nil {
+//     for _, e := range enum.InvalidValuesIn(err) {
+//       fmt.Println(e.Field, e.Value)
+//     }
+//   }
+func InvalidValuesIn(err error) []InvalidEnumError {
+	var out []InvalidEnumError
+	collectInvalid(err, "", &out)
+	return out
+}
+
+// The body of a response rejecting a request because of err, such as the 400 sent by enumgin
+// and enumecho, with one ErrorDetail per invalid enum err holds. An err without invalid enums
+// is reported as a single ErrorDetail holding its message
+//   w.WriteHeader(http.StatusBadRequest)
+//   json.NewEncoder(w).Encode(enum.NewErrorResponse(err)) // {"errors":[{"field":"currency","message":"GBP is not a valid enum"}]}
+type ErrorResponse struct {
+	Errors []ErrorDetail `json:"errors"`
+}
+
+// A problem with a single field of a request
+type ErrorDetail struct {
+	// The JSON path of the field, empty if the problem isn't with a particular field
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// Builds the ErrorResponse describing err, see ErrorResponse
+func NewErrorResponse(err error) ErrorResponse {
+	invalid := InvalidValuesIn(err)
+	if len(invalid) == 0 {
+		return ErrorResponse{Errors: []ErrorDetail{{Message: err.Error()}}}
+	}
+	out := make([]ErrorDetail, len(invalid))
+	for i, e := range invalid {
+		out[i] = ErrorDetail{Field: e.Field, Message: e.Error()}
+	}
+	return ErrorResponse{Errors: out}
+}
+
+func collectInvalid(err error, field string, out *[]InvalidEnumError) {
+	switch e := err.(type) {
+	case nil:
+		return
+	case *InvalidEnumError:
+		i := *e
+		if field != "" {
+			i.Field = field
+		}
+		*out = append(*out, i)
+		return
+	case *FieldError:
+		field = joinPath(field, e.Field)
+	}
+
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, err := range e.Unwrap() {
+			collectInvalid(err, field, out)
+		}
+	case interface{ Unwrap() error }:
+		collectInvalid(e.Unwrap(), field, out)
+	case interface{ Cause() error }:
+		collectInvalid(e.Cause(), field, out)
+	}
+}
+
+func (d *Descriptor) invalid(c Const) *InvalidEnumError {
+	return d.table().invalid(d.name, c)
+}
+
+// See Descriptor.invalid. Used by callers that already loaded the table c was rejected by, so
+// the error doesn't describe a table RemoteEnum.Refresh swapped in afterwards.
+func (t *constTable) invalid(name string, c Const) *InvalidEnumError {
+	k := t.lookup(c)
+	out := &InvalidEnumError{Type: name, Value: c, Retired: k != nil && k.retired}
+	if !out.Retired {
+		out.Suggestions = t.suggest(c)
+	}
+	return out
+}
+
+// Joins the Consts into a list like "A, B or C".
+func orList(cs []Const) string {
+	strs := make([]string, len(cs))
+	for i, c := range cs {
+		strs[i] = string(c)
+	}
+	if len(strs) == 1 {
+		return strs[0]
+	}
+	return strings.Join(strs[:len(strs)-1], ", ") + " or " + strs[len(strs)-1]
+}
@@ -0,0 +1,49 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+const notExtensibleErrorMsg = "%s is not extensible, tag its enum.Enum with extensible to allow Extend"
+
+// Adds c to the valid values of e's type at runtime, for plugin systems whose values aren't
+// known at compile time. Every enum of the type, including those already constructed, accepts
+// c from then on. Only enums whose embedded Enum is tagged extensible can be extended. Returns
+// an error if c is empty or already on the enum, compared regardless of case when
+// CaseInsensitive or Normalize is provided. Safe to call concurrently with itself and with Set
+//   type Provider struct {
+//     enum.Enum `extensible:""`
+//     Local enum.Const `enum:"LOCAL"`
+//   }
+//
+//   err := enum.Extend(new(Provider), "S3")
+func Extend(e Enummer, c Const, opts ...Option) error {
+	d, err := Describe(e)
+	if err != nil {
+		return err
+	}
+	if !d.extensible {
+		return errors.New(fmt.Sprintf(notExtensibleErrorMsg, d.name))
+	}
+	if c == "" {
+		return errors.New(emptyEnumErrorMsg)
+	}
+	o := newOptions(opts)
+
+	d.extendMu.Lock()
+	defer d.extendMu.Unlock()
+	t := d.table()
+	for _, con := range t.consts {
+		if o.matches(con.value, string(c)) {
+			return errors.New(fmt.Sprintf(duplicateEnumErrorMsg, c))
+		}
+	}
+	consts := make([]constant, len(t.consts), len(t.consts)+1)
+	copy(consts, t.consts)
+	con := constant{value: c, name: string(c)}
+	con.code, con.coded = nextCode(t.consts)
+	consts = append(consts, con)
+	d.tab.Store(newConstTable(d.name, consts))
+	return nil
+}
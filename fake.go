@@ -0,0 +1,26 @@
+package enum
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// Sets every enum within v to a random valid Const, for generating fixtures and load test
+// data. Enums are constructed afresh so their Const fields are correct even if something
+// else, such as a faker filling every string, overwrote them
+//   var money Money
+//   err := enum.FakeFill(&money)
+func FakeFill(v interface{}) error {
+	return FakeFillRand(v, nil)
+}
+
+// Same as FakeFill but draws values from r, or the math/rand default source if r is nil
+func FakeFillRand(v interface{}, r *rand.Rand) error {
+	return walk(reflect.ValueOf(v), "", jsonName, func(path string, e Enummer) error {
+		if err := construct(e); err != nil {
+			return err
+		}
+		e.unsafeSet(Random(e, r))
+		return nil
+	})
+}
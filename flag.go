@@ -0,0 +1,43 @@
+package enum
+
+// Adapts an enum to pflag.Value, and the standard library's flag.Value, so command line flags
+// are validated against the enum. Enum.Set can't be used directly as it takes a Const
+// rather than a string
+//   cc := enum.New(new(CurrencyCodes)).(*CurrencyCodes)
+//   cmd.Flags().Var(enum.Flag(cc), "currency", "the currency to charge in")
+type FlagValue struct {
+	e Enummer
+}
+
+// Creates a FlagValue which sets its value on e
+func Flag(e Enummer) *FlagValue {
+	return &FlagValue{e: e}
+}
+
+// Sets the enum from the flag's argument. Returns an error if the argument is invalid
+func (f *FlagValue) Set(s string) error {
+	if err := ensureConstructed(f.e); err != nil {
+		return err
+	}
+	return f.e.Set(Const(s))
+}
+
+func (f *FlagValue) String() string {
+	if f == nil || f.e == nil {
+		return ""
+	}
+	return string(f.e.Get())
+}
+
+// The name of the enum type, shown as the flag's type in help output
+func (f *FlagValue) Type() string {
+	if d, err := Describe(f.e); err == nil {
+		return d.Name()
+	}
+	return "string"
+}
+
+// The enum the flag sets
+func (f *FlagValue) Enum() Enummer {
+	return f.e
+}
@@ -0,0 +1,39 @@
+package enum
+
+import (
+	"fmt"
+)
+
+// Implements fmt.Formatter. %s and %v print the value, %q quotes it, %d prints its ordinal
+// (-1 if the enum isn't constructed or the value isn't declared), %+v prints Type(VALUE)
+// and %#v prints Type("VALUE") for debugging. Widths and flags are honoured
+//   cc := enum.MustConstruct(new(CurrencyCodes), enum.Const("CUSTOM"))
+//   fmt.Printf("%s %q %d %+v", cc, cc, cc, cc) // Prints CUSTOM "CUSTOM" 1 CurrencyCodes(CUSTOM)
+func (e Enum) Format(f fmt.State, verb rune) {
+	name := "Enum"
+	if e.desc != nil {
+		name = e.desc.name
+	}
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "%s(%s)", name, e.Get())
+			return
+		}
+		if f.Flag('#') {
+			fmt.Fprintf(f, "%s(%q)", name, e.Get())
+			return
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), string(e.Get()))
+	case 's', 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), string(e.Get()))
+	case 'd':
+		i := -1
+		if e.desc != nil {
+			i = e.desc.index(e.val)
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, verb), i)
+	default:
+		fmt.Fprintf(f, "%%!%c(%s=%s)", verb, name, e.Get())
+	}
+}
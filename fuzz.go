@@ -0,0 +1,46 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const fuzzInvariantErrorMsg = "fuzz: %s"
+
+// Runs untrusted input through the enum's parsing paths, for use as the body of a native
+// Go fuzz test. data is unmarshalled into e as JSON and validated, the error from which is
+// returned. data is also passed to Parse as a raw string. Panics if either path breaks an
+// invariant, such as accepting a value GetAll and Validate disagree with or marshalling a
+// value that doesn't unmarshal back to itself, as that points to a bug
+//   func FuzzCurrencyCodes(f *testing.F) {
+//     f.Add([]byte(`"USD"`))
+//     f.Fuzz(func(t *testing.T, data []byte) {
+//       _ = enum.FuzzParse(new(CurrencyCodes), data)
+//     })
+//   }
+func FuzzParse(e Enummer, data []byte) error {
+	if err := ensureConstructed(e); err != nil {
+		return err
+	}
+
+	if c, err := Parse(e, string(data)); err == nil && !e.base().valid(c) {
+		panic(fmt.Sprintf(fuzzInvariantErrorMsg, "Parse accepted "+string(c)+" which is not valid"))
+	}
+
+	if err := e.base().UnmarshalJSON(data); err != nil {
+		return err
+	}
+	if err := Validate(e); err != nil {
+		return err
+	}
+
+	out, err := json.Marshal(e.base())
+	if err != nil {
+		panic(fmt.Sprintf(fuzzInvariantErrorMsg, "valid value failed to marshal: "+err.Error()))
+	}
+	back := Enum{desc: e.base().desc}
+	if err := back.UnmarshalJSON(out); err != nil || back.val != e.Get() {
+		panic(fmt.Sprintf(fuzzInvariantErrorMsg, "value did not survive a round trip: "+string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package enum
+
+// The general data type GORM gives enum columns. The enumgorm package can narrow it per
+// dialect through GormDBDataType
+func (e Enum) GormDataType() string {
+	return "string"
+}
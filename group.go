@@ -0,0 +1,33 @@
+package enum
+
+// The group c belongs to, declared with its group tag. Empty if it has none or isn't on the
+// enum
+//   type Currencies struct {
+//     enum.Enum
+//     USD enum.Const `group:"fiat"`
+//     EUR enum.Const `group:"fiat"`
+//     BTC enum.Const `group:"crypto"`
+//   }
+//
+//   fmt.Println(cur.Group(cur.BTC)) // Prints "crypto"
+func (e *Enum) Group(c Const) string {
+	if e.desc == nil {
+		return ""
+	}
+	if k := e.desc.lookup(c); k != nil {
+		return k.group
+	}
+	return ""
+}
+
+// The Consts GetAll returns which belong to the group called name, in the same order
+//   fmt.Println(cur.ByGroup("fiat")) // Prints [USD EUR]
+func (e *Enum) ByGroup(name string) []Const {
+	var out []Const
+	for _, c := range e.all() {
+		if e.Group(c) == name {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+const unknownParentErrorMsg = "parent %s of %s is not a value of the enum"
+const parentCycleErrorMsg = "%s is its own ancestor"
+
+// The Const c sits under, declared with its parent tag. Returns false if c has no parent or
+// isn't on the enum
+//   type Regions struct {
+//     enum.Enum
+//     Europe  enum.Const `enum:"EUROPE"`
+//     France  enum.Const `enum:"FR" parent:"EUROPE"`
+//     Germany enum.Const `enum:"DE" parent:"EUROPE"`
+//   }
+//
+//   p, _ := r.Parent(r.France)
+//   fmt.Println(p) // Prints "EUROPE"
+func (e *Enum) Parent(c Const) (Const, bool) {
+	if e.desc == nil {
+		return "", false
+	}
+	k := e.desc.lookup(c)
+	if k == nil || k.parent == "" {
+		return "", false
+	}
+	return k.parent, true
+}
+
+// The Consts GetAll returns whose parent is c, in the same order
+//   fmt.Println(r.Children(r.Europe)) // Prints [FR DE]
+func (e *Enum) Children(c Const) []Const {
+	var out []Const
+	for _, child := range e.all() {
+		if p, ok := e.Parent(child); ok && p == c {
+			out = append(out, child)
+		}
+	}
+	return out
+}
+
+// Whether c is ancestor or sits anywhere beneath it, following parents up from c
+//   if !r.IsWithin(r.Get(), r.Europe) {
+//     return errors.New("only European regions are supported")
+//   }
+func (e *Enum) IsWithin(c, ancestor Const) bool {
+	if e.desc == nil || !e.desc.has(c) {
+		return false
+	}
+	for {
+		if c == ancestor {
+			return true
+		}
+		p, ok := e.Parent(c)
+		if !ok {
+			return false
+		}
+		c = p
+	}
+}
+
+// Checks that every parent is one of consts and that no Const is its own ancestor.
+func checkParents(consts []constant) error {
+	parents := make(map[Const]Const, len(consts))
+	for _, c := range consts {
+		parents[c.value] = c.parent
+	}
+	for _, c := range consts {
+		if c.parent == "" {
+			continue
+		}
+		if _, ok := parents[c.parent]; !ok {
+			return errors.New(fmt.Sprintf(unknownParentErrorMsg, c.parent, c.name))
+		}
+		for p, steps := c.parent, 0; p != ""; p, steps = parents[p], steps+1 {
+			if p == c.value || steps > len(consts) {
+				return errors.New(fmt.Sprintf(parentCycleErrorMsg, c.name))
+			}
+		}
+	}
+	return nil
+}
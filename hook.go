@@ -0,0 +1,89 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+const numericHookErrorMsg = "cannot decode the number %s into %s, only enums with the int or proto format are read from numbers"
+
+// Returns a mapstructure.DecodeHookFunc which constructs and validates enums from strings so
+// config files decoded through mapstructure, or viper.Unmarshal, populate enum fields. Strings
+// are decoded through the enum's Codec and loose tag like UnmarshalJSON. Numbers are read as
+// codes, see Enum.Code, for config written with enums' integers, but only for enums tagged
+// with the int or proto format
+//   err := viper.Unmarshal(&cfg, viper.DecodeHook(enum.DecodeHookFunc()))
+func DecodeHookFunc() func(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	return func(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+		t := to
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || !reflect.PtrTo(t).Implements(enummerType) {
+			return data, nil
+		}
+		out := reflect.New(t)
+		e := out.Interface().(Enummer)
+		v := reflect.ValueOf(data)
+		switch {
+		case v.Kind() == reflect.String:
+			if err := construct(e); err != nil {
+				return nil, err
+			}
+			if err := e.base().UnmarshalJSON([]byte(strconv.Quote(v.String()))); err != nil {
+				return nil, err
+			}
+			if err := Validate(e); err != nil {
+				return nil, err
+			}
+		case v.CanInt() || v.CanUint() || v.CanFloat():
+			c, err := hookCode(t, v)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := Construct(e, c); err != nil {
+				return nil, err
+			}
+		default:
+			return data, nil
+		}
+
+		if to.Kind() == reflect.Ptr {
+			return out.Interface(), nil
+		}
+		return out.Elem().Interface(), nil
+	}
+}
+
+// The Const of the enum type t whose code is the number v. Only enums tagged with the int or
+// proto format are encoded as numbers, so v is rejected for any other.
+func hookCode(t reflect.Type, v reflect.Value) (Const, error) {
+	d, err := describe(t)
+	if err != nil {
+		return "", err
+	}
+	if d.format != intFormat && d.format != protoFormat {
+		return "", errors.New(fmt.Sprintf(numericHookErrorMsg, fmt.Sprint(v.Interface()), d.name))
+	}
+	tab := d.table()
+	i := -1
+	switch {
+	case v.CanInt():
+		i = tab.byCode(int(v.Int()))
+	case v.CanUint():
+		if u := v.Uint(); u <= math.MaxInt {
+			i = tab.byCode(int(u))
+		}
+	default:
+		if f := v.Float(); f == math.Trunc(f) && f >= math.MinInt && f < math.MaxInt {
+			i = tab.byCode(int(f))
+		}
+	}
+	if i < 0 {
+		return "", errors.New(fmt.Sprintf(unknownCodeErrorMsg, fmt.Sprint(v.Interface()), d.name))
+	}
+	return tab.consts[i].value, nil
+}
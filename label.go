@@ -0,0 +1,21 @@
+package enum
+
+// The values of every Const the enum can hold as strings, including deprecated Consts but not
+// retired ones, for use as metric label values. Since enums have a fixed set of values this
+// bounds the cardinality of the label. Panics if the enum is declared incorrectly
+//   for _, v := range enum.LabelValues(new(OrderStatus)) {
+//     ordersByStatus.WithLabelValues(v) // <-- every status is exported from the start
+//   }
+func LabelValues(e Enummer) []string {
+	d, err := Describe(e)
+	if err != nil {
+		panic(err.Error())
+	}
+	var out []string
+	for _, c := range d.table().consts {
+		if !c.retired {
+			out = append(out, string(c.value))
+		}
+	}
+	return out
+}
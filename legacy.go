@@ -0,0 +1,55 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+	"strings"
+)
+
+const legacyClashErrorMsg = "legacy value %s of %s is already a value of the enum"
+const duplicateLegacyErrorMsg = "legacy value %s is used by both %s and %s"
+
+// The values a Const was persisted as before it was renamed, given by its legacy tag as a
+// comma separated list. Legacy values are read as the Const wherever the enum is unmarshalled
+// or parsed, and when Validate runs on an enum unmarshalled before it was constructed, while
+// the Const's current value is always written. Renaming a value therefore needs no migration
+// of stored data
+//   type CurrencyCodes struct {
+//     enum.Enum
+//     USD enum.Const `legacy:"US_DOLLAR,USDOLLAR"`
+//   }
+//
+//   json.Unmarshal([]byte(`"US_DOLLAR"`), cc)
+//   out, _ := json.Marshal(cc) // "USD"
+func legacyValues(f reflect.StructField) []Const {
+	var out []Const
+	for _, v := range strings.Split(f.Tag.Get("legacy"), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, Const(v))
+		}
+	}
+	return out
+}
+
+// Checks that no legacy value is a current value or the legacy value of two Consts, either
+// of which would make reading it ambiguous.
+func checkLegacy(consts []constant) error {
+	values := make(map[Const]bool, len(consts))
+	for _, c := range consts {
+		values[c.value] = true
+	}
+	seen := make(map[Const]string)
+	for _, c := range consts {
+		for _, l := range c.legacy {
+			if values[l] {
+				return errors.New(fmt.Sprintf(legacyClashErrorMsg, l, c.name))
+			}
+			if other, ok := seen[l]; ok && other != c.name {
+				return errors.New(fmt.Sprintf(duplicateLegacyErrorMsg, l, other, c.name))
+			}
+			seen[l] = c.name
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Translation tables keyed by the *Descriptor of the enum they translate.
+var translations sync.Map
+
+type translationTables struct {
+	mu     sync.RWMutex
+	tables map[string]map[Const]string
+}
+
+// Registers translations of the enum's values into lang, a BCP 47 tag such as "fr" or
+// "pt-BR". Registering the same lang again merges the tables. Returns an error if the
+// table holds a value which isn't on the enum
+//   enum.RegisterTranslations(new(CurrencyCodes), "fr", map[enum.Const]string{
+//     "USD": "Dollar américain",
+//   })
+func RegisterTranslations(e Enummer, lang string, table map[Const]string) error {
+	d, err := Describe(e)
+	if err != nil {
+		return err
+	}
+	tag := normalizeLang(lang)
+	if tag == "" {
+		return errors.New(fmt.Sprintf(invalidLangErrorMsg, lang))
+	}
+	for c := range table {
+		if !d.has(c) {
+			return d.invalid(c)
+		}
+	}
+
+	t, _ := translations.LoadOrStore(d, &translationTables{tables: make(map[string]map[Const]string)})
+	tt := t.(*translationTables)
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	if tt.tables[tag] == nil {
+		tt.tables[tag] = make(map[Const]string, len(table))
+	}
+	for c, s := range table {
+		tt.tables[tag][c] = s
+	}
+	return nil
+}
+
+// Renders the enum's current value in lang. When lang has no translation, less specific
+// tags are tried, so "fr-CA" falls back to "fr", before falling back to the DisplayName
+//   fmt.Println(enum.Localize(cc, "fr-CA")) // Prints "Dollar américain"
+func Localize(e Enummer, lang string) string {
+	d, err := Describe(e)
+	if err != nil {
+		return string(e.Get())
+	}
+	if t, ok := translations.Load(d); ok {
+		tt := t.(*translationTables)
+		tt.mu.RLock()
+		defer tt.mu.RUnlock()
+		for l := normalizeLang(lang); l != ""; l = parentLang(l) {
+			if s, ok := tt.tables[l][e.Get()]; ok {
+				return s
+			}
+		}
+	}
+	return d.displayName(e.Get())
+}
+
+// The languages translations have been registered for on the enum, sorted
+func Languages(e Enummer) []string {
+	var out []string
+	d, err := Describe(e)
+	if err != nil {
+		return out
+	}
+	if t, ok := translations.Load(d); ok {
+		tt := t.(*translationTables)
+		tt.mu.RLock()
+		for l := range tt.tables {
+			out = append(out, l)
+		}
+		tt.mu.RUnlock()
+	}
+	sort.Strings(out)
+	return out
+}
+
+const invalidLangErrorMsg = "%q is not a valid language"
+
+func normalizeLang(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+}
+
+func parentLang(lang string) string {
+	if i := strings.LastIndex(lang, "-"); i >= 0 {
+		return lang[:i]
+	}
+	return ""
+}
@@ -0,0 +1,30 @@
+package enum
+
+import (
+	"sync/atomic"
+)
+
+// Receives warnings from the package, such as a deprecated Const being set. *log.Logger
+// satisfies this interface
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+type loggerHolder struct {
+	l Logger
+}
+
+var logger atomic.Value
+
+// Sets the Logger warnings are written to. Warnings are discarded until a Logger is set,
+// pass nil to discard them again
+//   enum.SetLogger(log.New(os.Stderr, "enum: ", log.LstdFlags))
+func SetLogger(l Logger) {
+	logger.Store(loggerHolder{l: l})
+}
+
+func logf(format string, v ...interface{}) {
+	if h, ok := logger.Load().(loggerHolder); ok && h.l != nil {
+		h.l.Printf(format, v...)
+	}
+}
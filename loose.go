@@ -0,0 +1,44 @@
+package enum
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Unmarshals b for an enum whose Enum is tagged loose, for APIs which aren't consistent
+// about how they encode it. A JSON number is read as a code, see Enum.Code, which for enums
+// without code tags is the ordinal. A string is matched against the values and aliases
+// regardless of case, then read as a code if it is an integer, and kept as is otherwise so
+// Validate can reject it. Either way the enum is left holding the Const as declared. Until the
+// enum is constructed its loose tag is unknown, so values unmarshalled before then are
+// matched when Validate constructs it
+//   type Status struct {
+//     enum.Enum `loose:""`
+//     Active    enum.Const
+//     Closed    enum.Const
+//   }
+//
+//   json.Unmarshal([]byte(`1`), &s)        // Closed
+//   json.Unmarshal([]byte(`"active"`), &s) // Active
+//   json.Unmarshal([]byte(`"1"`), &s)      // Closed
+func (e *Enum) unmarshalLoose(b []byte) error {
+	if len(b) > 0 && (b[0] == '-' || (b[0] >= '0' && b[0] <= '9')) {
+		return e.unmarshalCode(b)
+	}
+	if len(b) > 0 && b[0] == '{' && e.desc.format == objectFormat {
+		return e.unmarshalObject(b)
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if c, ok := e.desc.resolve(s, &options{caseInsensitive: true}); ok {
+		e.unsafeSet(c)
+		return nil
+	}
+	if _, err := strconv.Atoi(s); err == nil {
+		return e.unmarshalCode([]byte(s))
+	}
+	e.unsafeSet(Const(s))
+	return nil
+}
@@ -0,0 +1,48 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+const malformedMetaErrorMsg = "meta tag on %s is malformed, expected key=value pairs but got %q"
+
+// The metadata declared on c with the meta tag, a comma separated list of key=value pairs.
+// Returns nil if c has no metadata or isn't on the enum
+//   type CurrencyCodes struct {
+//     enum.Enum
+//     USD enum.Const `meta:"symbol=$,decimals=2"`
+//     JPY enum.Const `meta:"symbol=¥,decimals=0"`
+//   }
+//
+//   fmt.Println(cc.Meta(cc.JPY)["decimals"]) // Prints "0"
+func (e *Enum) Meta(c Const) map[string]string {
+	if e.desc == nil {
+		return nil
+	}
+	con := e.desc.lookup(c)
+	if con == nil || len(con.meta) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(con.meta))
+	for k, v := range con.meta {
+		out[k] = v
+	}
+	return out
+}
+
+func parseMeta(name, tag string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, pair := range strings.Split(tag, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		if k = strings.TrimSpace(k); !ok || k == "" {
+			return nil, errors.New(fmt.Sprintf(malformedMetaErrorMsg, name, pair))
+		}
+		out[k] = strings.TrimSpace(v)
+	}
+	return out, nil
+}
@@ -0,0 +1,193 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+const duplicateSelectionErrorMsg = "%s is selected more than once"
+const tooFewSelectedErrorMsg = "at least %d values must be selected but got %d"
+const tooManySelectedErrorMsg = "at most %d values can be selected but got %d"
+
+// Several Consts from the enum type T held at once, in the order they were selected, for
+// fields such as tags or capabilities. Like Set, T is the enum struct itself and the zero
+// value is ready to use. Unlike Set a Const can't be selected twice and the number selected
+// can be limited, see Limit. Marshals as a JSON array and unmarshals from either an array or
+// a comma separated string
+//   type Listing struct {
+//     Features enum.Multi[Feature] `json:"features"` // ["WIFI","PARKING"] or "WIFI,PARKING"
+//   }
+type Multi[T any] struct {
+	desc *Descriptor
+	vals []Const
+	min  int
+	max  int
+}
+
+// Creates a Multi holding the provided Consts. Returns an error if any of them can't be set on
+// e or is provided twice
+//   m, err := enum.NewMulti(new(Feature), "WIFI", "PARKING")
+func NewMulti[T any](e *T, cs ...Const) (Multi[T], error) {
+	m := Multi[T]{}
+	d, err := describeType[T](e)
+	if err != nil {
+		return m, err
+	}
+	m.desc = d
+	return m, m.Add(cs...)
+}
+
+// Limits how many Consts can be selected. Add rejects going over max, while fewer than min
+// is only reported by Validate and when unmarshalling since a selection is usually built up
+// one at a time. A max of 0 or less leaves the number unbounded. Limits must be set before
+// unmarshalling, just as enums must be constructed before unmarshalling codes
+//   listing := Listing{}
+//   listing.Features.Limit(1, 3)
+//   err := json.Unmarshal(b, &listing)
+func (m *Multi[T]) Limit(min, max int) {
+	m.min, m.max = min, max
+}
+
+// Selects the Consts after those already selected. Returns an error, leaving the selection
+// untouched, if any of them can't be set on the enum, is already selected or would take the
+// selection over its limit
+func (m *Multi[T]) Add(cs ...Const) error {
+	d, err := m.descriptor()
+	if err != nil {
+		return err
+	}
+	seen := make(map[Const]bool, len(m.vals)+len(cs))
+	for _, c := range m.vals {
+		seen[c] = true
+	}
+	vals := make([]Const, len(cs))
+	for i, c := range cs {
+		vals[i] = d.canonical(c)
+		if k := d.lookup(vals[i]); k == nil || k.retired {
+			return d.invalid(c)
+		}
+		if seen[vals[i]] {
+			return errors.New(fmt.Sprintf(duplicateSelectionErrorMsg, c))
+		}
+		seen[vals[i]] = true
+	}
+	if n := len(m.vals) + len(vals); m.max > 0 && n > m.max {
+		return errors.New(fmt.Sprintf(tooManySelectedErrorMsg, m.max, n))
+	}
+	m.vals = append(m.vals, vals...)
+	return nil
+}
+
+// Deselects the Consts, or those their aliases and legacy values stand for, ignoring any
+// that aren't selected
+func (m *Multi[T]) Remove(cs ...Const) {
+	vals := make([]Const, len(cs))
+	for i, c := range cs {
+		vals[i] = m.desc.canonical(c)
+	}
+	out := m.vals[:0]
+	for _, v := range m.vals {
+		if !contains(vals, v) {
+			out = append(out, v)
+		}
+	}
+	m.vals = out
+}
+
+// Whether c, or the Const it is an alias or legacy value of, is selected
+func (m Multi[T]) Contains(c Const) bool {
+	return contains(m.vals, m.desc.canonical(c))
+}
+
+// The number of Consts selected
+func (m Multi[T]) Len() int {
+	return len(m.vals)
+}
+
+// The selected Consts in the order they were selected
+func (m Multi[T]) Values() []Const {
+	return append(make([]Const, 0, len(m.vals)), m.vals...)
+}
+
+// Checks the number of Consts selected against the limits set by Limit
+func (m Multi[T]) Validate() error {
+	if n := len(m.vals); n < m.min {
+		return errors.New(fmt.Sprintf(tooFewSelectedErrorMsg, m.min, n))
+	}
+	if n := len(m.vals); m.max > 0 && n > m.max {
+		return errors.New(fmt.Sprintf(tooManySelectedErrorMsg, m.max, n))
+	}
+	return nil
+}
+
+// The selected Consts separated by commas
+func (m Multi[T]) String() string {
+	return strings.Join(Strings(m.vals), ",")
+}
+
+// Marshals the selection into a JSON array of its Consts
+func (m Multi[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Values())
+}
+
+// Unmarshals a JSON array of strings or a comma separated string into the selection, replacing
+// it. Like Set, every value is validated straight away, as are the limits
+func (m *Multi[T]) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		return m.UnmarshalText([]byte(s))
+	}
+	var vals []Const
+	if err := json.Unmarshal(b, &vals); err != nil {
+		return err
+	}
+	return m.replace(vals)
+}
+
+func (m Multi[T]) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// Unmarshals a comma separated list of Consts into the selection, replacing it. Whitespace
+// around each Const is ignored
+func (m *Multi[T]) UnmarshalText(b []byte) error {
+	var vals []Const
+	for _, s := range strings.Split(string(b), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			vals = append(vals, Const(s))
+		}
+	}
+	return m.replace(vals)
+}
+
+// Swaps the selection for vals if they are valid and within the limits.
+func (m *Multi[T]) replace(vals []Const) error {
+	out := Multi[T]{desc: m.desc, min: m.min, max: m.max}
+	if err := out.Add(vals...); err != nil {
+		return err
+	}
+	if err := out.Validate(); err != nil {
+		return err
+	}
+	*m = out
+	return nil
+}
+
+func (m *Multi[T]) descriptor() (*Descriptor, error) {
+	if m.desc == nil {
+		d, err := describeType[T](nil)
+		if err != nil {
+			return nil, err
+		}
+		m.desc = d
+	}
+	return m.desc, nil
+}
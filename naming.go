@@ -0,0 +1,112 @@
+package enum
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// Splits an identifier such as "HTTPStatusCode" or "status_code" into its words.
+func splitWords(s string) []string {
+	var words []string
+	rs := []rune(s)
+	start := 0
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		if r == '_' || r == '-' || r == ' ' || r == '.' {
+			if i > start {
+				words = append(words, string(rs[start:i]))
+			}
+			start = i + 1
+			continue
+		}
+		if i > start && unicode.IsUpper(r) {
+			prev := rs[i-1]
+			nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				words = append(words, string(rs[start:i]))
+				start = i
+			}
+		}
+	}
+	if start < len(rs) {
+		words = append(words, string(rs[start:]))
+	}
+	return words
+}
+
+func screamingSnake(s string) string {
+	return strings.ToUpper(strings.Join(splitWords(s), "_"))
+}
+
+// Joins the words of s with every word but the first capitalised, e.g. "HTTPStatus" becomes
+// "httpStatus".
+func camel(s string) string {
+	words := splitWords(s)
+	for i, w := range words {
+		w = strings.ToLower(w)
+		if i > 0 {
+			rs := []rune(w)
+			rs[0] = unicode.ToUpper(rs[0])
+			w = string(rs)
+		}
+		words[i] = w
+	}
+	return strings.Join(words, "")
+}
+
+const unknownCaseErrorMsg = "%s is not a known case"
+
+// A naming convention used to turn field names into Const values. Set it with the case tag
+// on the embedded Enum. Fields with an enum tag keep the value from their tag
+//   type CurrencyCodes struct {
+//     enum.Enum `case:"snake"`
+//     UsDollar  enum.Const // "us_dollar"
+//     Euro      enum.Const // "euro"
+//   }
+type Case string
+
+const (
+	// UsDollar becomes "usdollar"
+	LowerCase Case = "lower"
+	// UsDollar becomes "USDOLLAR"
+	UpperCase Case = "upper"
+	// UsDollar becomes "us_dollar"
+	SnakeCase Case = "snake"
+	// UsDollar becomes "us-dollar"
+	KebabCase Case = "kebab"
+	// UsDollar becomes "US_DOLLAR"
+	ScreamingSnakeCase Case = "screaming_snake"
+	// UsDollar becomes "usDollar"
+	CamelCase Case = "camel"
+)
+
+// Applies the naming convention to name. Returns false if the case is unknown
+func (c Case) Apply(name string) (string, bool) {
+	switch c {
+	case "":
+		return name, true
+	case LowerCase:
+		return strings.ToLower(name), true
+	case UpperCase:
+		return strings.ToUpper(name), true
+	case SnakeCase:
+		return strings.ToLower(strings.Join(splitWords(name), "_")), true
+	case KebabCase:
+		return strings.ToLower(strings.Join(splitWords(name), "-")), true
+	case ScreamingSnakeCase:
+		return screamingSnake(name), true
+	case CamelCase:
+		return camel(name), true
+	}
+	return "", false
+}
+
+// The naming convention set by the case tag on an enum's Enum. Enums tagged format:"proto"
+// default to ScreamingSnakeCase to match protobuf's enum value names.
+func typeCase(tag reflect.StructTag) Case {
+	if c, ok := tag.Lookup("case"); ok || tag.Get("format") != "proto" {
+		return Case(c)
+	}
+	return ScreamingSnakeCase
+}
@@ -0,0 +1,36 @@
+package enum
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"sync"
+)
+
+// Case folders for normalize. A cases.Caser holds state while it transforms a string so
+// each may only be used by one goroutine at a time.
+var folders = sync.Pool{New: func() interface{} {
+	c := cases.Fold()
+	return &c
+}}
+
+// s in NFC form with its case folded, so strings which only differ in how their characters
+// are composed or cased compare equal.
+func normalize(s string) string {
+	folder := folders.Get().(*cases.Caser)
+	defer folders.Put(folder)
+	return folder.String(norm.NFC.String(s))
+}
+
+// Whether s matches the Const or alias c under the provided options.
+func (o *options) matches(c Const, s string) bool {
+	switch {
+	case string(c) == s:
+		return true
+	case o.normalize:
+		return normalize(string(c)) == normalize(s)
+	case o.caseInsensitive:
+		return strings.EqualFold(string(c), s)
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+	"strconv"
+)
+
+const invalidCodeErrorMsg = "code tag on %s must be an integer but got %q"
+const duplicateCodeErrorMsg = "code %d is used by both %s and %s"
+const missingCodeErrorMsg = "code tag missing on %s, either every Const or none must have one"
+const unknownCodeErrorMsg = "%s is not a valid code for %s"
+const invalidStartErrorMsg = "start tag on %s must be an integer but got %q"
+
+// The integer code of c, taken from its code tag or, for enums without code tags, its ordinal.
+// Enums tagged format:"int" without code tags are numbered in the order their Consts are
+// declared instead, starting from the start tag or 0, just like iota.
+// Returns false if c is not on the enum. Enums whose Enum is tagged format:"int" marshal to
+// JSON as their code and unmarshal from either the code or the string value. Codes can only
+// be unmarshalled once the enum has been constructed, see New
+//   type Priority struct {
+//     enum.Enum `format:"int"`
+//     Low  enum.Const `enum:"LOW" code:"10"`
+//     High enum.Const `enum:"HIGH" code:"20"`
+//   }
+//
+//   out, _ := json.Marshal(p)
+//   fmt.Println(string(out)) // Prints 20 when p is HIGH
+func (e *Enum) Code(c Const) (int, bool) {
+	if e.desc == nil {
+		return 0, false
+	}
+	return e.desc.Code(c)
+}
+
+// The integer code of c, see Enum.Code. Returns false if c is not on the enum
+func (d *Descriptor) Code(c Const) (int, bool) {
+	t := d.table()
+	i := t.index(c)
+	if i < 0 {
+		return 0, false
+	}
+	return t.code(i), true
+}
+
+// The code of the Const at ordinal i.
+func (t *constTable) code(i int) int {
+	if t.consts[i].coded {
+		return t.consts[i].code
+	}
+	return i
+}
+
+// The ordinal of the Const with the provided code or -1 if there is none.
+func (t *constTable) byCode(code int) int {
+	for i := range t.consts {
+		if t.code(i) == code {
+			return i
+		}
+	}
+	return -1
+}
+
+// Numbers the Consts of enums encoded as integers by the order they are declared in, starting
+// at the start tag, so enums ported from iota keep their integers. Leaves the Consts alone if
+// any of them has a code tag
+//   type Weekday struct {
+//     enum.Enum `format:"int" start:"1"`
+//     Monday    enum.Const // 1
+//     Tuesday   enum.Const // 2
+//   }
+func numberConsts(name string, tag reflect.StructTag, consts []constant) error {
+	start := 0
+	if s, ok := tag.Lookup("start"); ok {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return errors.New(fmt.Sprintf(invalidStartErrorMsg, name, s))
+		}
+		start = n
+	}
+	for _, c := range consts {
+		if c.coded {
+			return nil
+		}
+	}
+	for i := range consts {
+		consts[i].code = start + i
+		consts[i].coded = true
+	}
+	return nil
+}
+
+// The code for a Const appended to consts by Extend, one past the highest so far, or false if
+// the Consts aren't coded and the ordinal will do.
+func nextCode(consts []constant) (int, bool) {
+	if len(consts) == 0 || !consts[0].coded {
+		return 0, false
+	}
+	next := consts[0].code
+	for _, c := range consts {
+		if c.code >= next {
+			next = c.code + 1
+		}
+	}
+	return next, true
+}
+
+// Checks that codes are unique and, for enums encoded as integers, that every Const has one
+// if any do since mixing codes and ordinals would be ambiguous.
+func checkCodes(consts []constant, numeric bool) error {
+	seen := make(map[int]string)
+	coded := 0
+	for _, c := range consts {
+		if !c.coded {
+			continue
+		}
+		coded++
+		if name, ok := seen[c.code]; ok {
+			return errors.New(fmt.Sprintf(duplicateCodeErrorMsg, c.code, name, c.name))
+		}
+		seen[c.code] = c.name
+	}
+	if numeric && coded > 0 && coded < len(consts) {
+		for _, c := range consts {
+			if !c.coded {
+				return errors.New(fmt.Sprintf(missingCodeErrorMsg, c.name))
+			}
+		}
+	}
+	return nil
+}
+
+// Marshals the enum's value as its code, or null if it has no value.
+func (e Enum) marshalCode() ([]byte, error) {
+	if e.val == "" {
+		return []byte("null"), nil
+	}
+	t := e.desc.table()
+	i := t.index(e.val)
+	if i < 0 {
+		return nil, e.desc.invalid(e.val)
+	}
+	return []byte(strconv.Itoa(t.code(i))), nil
+}
+
+// Unmarshals a JSON number as a code. Only used once the enum is constructed since until
+// then there is no telling whether it is encoded as an integer, so numbers unmarshalled
+// before then are kept until construct.
+func (e *Enum) unmarshalCode(b []byte) error {
+	code, err := strconv.Atoi(string(b))
+	if err != nil {
+		return errors.New(fmt.Sprintf(unknownCodeErrorMsg, b, e.desc.name))
+	}
+	t := e.desc.table()
+	i := t.byCode(code)
+	if i < 0 {
+		return errors.New(fmt.Sprintf(unknownCodeErrorMsg, b, e.desc.name))
+	}
+	e.unsafeSet(t.consts[i].value)
+	return nil
+}
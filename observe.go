@@ -0,0 +1,76 @@
+package enum
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"weak"
+)
+
+// The observers registered through OnChange, keyed by a weak pointer to the Enum they were
+// registered on so enums which are never observed don't carry room for them. An entry is
+// dropped once its enum has been garbage collected.
+var instanceObservers sync.Map
+
+// The number of entries in instanceObservers, which lets Set skip looking for its own.
+var observedCount atomic.Int64
+
+// Registers fn to be called whenever Set or MustSet succeeds on this enum, including when
+// the value set is the one already held. Values stored without Set, such as by
+// unmarshalling, aren't observed. Observers belong to the enum they were registered on and
+// aren't carried over to copies of it
+//   o.OnChange(func(old, new enum.Const) {
+//     log.Printf("order moved from %s to %s", old, new)
+//   })
+func (e *Enum) OnChange(fn func(old, new Const)) {
+	key := weak.Make(e)
+	var fns []func(old, new Const)
+	cur, ok := instanceObservers.Load(key)
+	if ok {
+		fns = append(fns, cur.([]func(old, new Const))...)
+	}
+	instanceObservers.Store(key, append(fns, fn))
+	if !ok {
+		observedCount.Add(1)
+		runtime.AddCleanup(e, func(key weak.Pointer[Enum]) {
+			instanceObservers.Delete(key)
+			observedCount.Add(-1)
+		}, key)
+	}
+}
+
+// Registers fn to be called whenever Set or MustSet succeeds on any enum of e's type. Type
+// observers are called before those registered on the instance. Returns an error if the
+// enum is declared incorrectly
+//   err := enum.OnTypeChange(new(OrderStatus), audit)
+func OnTypeChange(e Enummer, fn func(old, new Const)) error {
+	d, err := Describe(e)
+	if err != nil {
+		return err
+	}
+	d.observersMu.Lock()
+	defer d.observersMu.Unlock()
+	var fns []func(old, new Const)
+	if cur := d.observers.Load(); cur != nil {
+		fns = append(fns, *cur...)
+	}
+	fns = append(fns, fn)
+	d.observers.Store(&fns)
+	return nil
+}
+
+func (e *Enum) notify(old, new Const) {
+	if fns := e.desc.observers.Load(); fns != nil {
+		for _, fn := range *fns {
+			fn(old, new)
+		}
+	}
+	if observedCount.Load() == 0 {
+		return
+	}
+	if fns, ok := instanceObservers.Load(weak.Make(e)); ok {
+		for _, fn := range fns.([]func(old, new Const)) {
+			fn(old, new)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package enum
+
+import (
+	"time"
+)
+
+// Configures how a value is validated, parsed or loaded
+type Option func(o *options)
+
+type options struct {
+	lenient         bool
+	caseInsensitive bool
+	normalize       bool
+	ttl             time.Duration
+	env             bool
+	envPrefix       string
+}
+
+// Accepts retired values when validating. Each one accepted is logged so its use can be
+// tracked down, see SetLogger
+//   err := enum.Validate(&money.CurrencyCode, enum.Lenient())
+func Lenient() Option {
+	return func(o *options) {
+		o.lenient = true
+	}
+}
+
+// Matches values regardless of case when parsing, so "usd" is read as USD
+//   c, err := enum.Parse(new(CurrencyCodes), "usd", enum.CaseInsensitive())
+func CaseInsensitive() Option {
+	return func(o *options) {
+		o.caseInsensitive = true
+	}
+}
+
+// Matches values after normalizing them to NFC and folding their case, so values holding
+// non-ASCII characters match however they were composed or cased. A decomposed "e\u0301"
+// sent by a browser is read as "é", and "STRASSE" as "straße"
+//   c, err := enum.Parse(new(Cities), "ZÜRICH", enum.Normalize())
+func Normalize() Option {
+	return func(o *options) {
+		o.normalize = true
+	}
+}
+
+// Reloads the values of an enum loaded with LoadFrom or LoadFromDB once they are older than
+// ttl. The reload happens the next time Set or GetAll is called
+//   tiers, err := enum.LoadFromDB(ctx, "Tier", db, "SELECT code FROM tiers", enum.TTL(time.Hour))
+func TTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.ttl = ttl
+	}
+}
+
+// Makes ValidateConfig fill each enum from the environment before validating, naming the
+// variables as LoadEnv does behind prefix
+//   err := enum.ValidateConfig(&cfg, enum.Env("APP"))
+func Env(prefix string) Option {
+	return func(o *options) {
+		o.env = true
+		o.envPrefix = prefix
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := new(options)
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
@@ -0,0 +1,37 @@
+package enum
+
+import (
+	"sort"
+)
+
+const invalidOrderErrorMsg = "order tag on %s must be an integer but got %q"
+
+// A list of all possible Consts on the enum sorted by less. The sort is stable so Consts
+// less considers equal keep their usual order
+//   levels := sev.GetAllSorted(func(a, b enum.Const) bool { return a < b })
+func (e *Enum) GetAllSorted(less func(a, b Const) bool) []Const {
+	out := append([]Const(nil), e.all()...)
+	sort.SliceStable(out, func(i, j int) bool {
+		return less(out[i], out[j])
+	})
+	return out
+}
+
+// Orders the Consts by their order tag. Consts without the tag follow those with it, and
+// otherwise Consts keep the order they are declared in. The result is the order of GetAll
+// and of the ordinals
+//   type Severity struct {
+//     enum.Enum
+//     Warning  enum.Const `order:"2"`
+//     Info     enum.Const `order:"1"`
+//     Critical enum.Const `order:"3"`
+//   }
+func sortConsts(consts []constant) {
+	sort.SliceStable(consts, func(i, j int) bool {
+		a, b := consts[i], consts[j]
+		if a.ordered != b.ordered {
+			return a.ordered
+		}
+		return a.order < b.order
+	})
+}
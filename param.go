@@ -0,0 +1,8 @@
+package enum
+
+// Implements the BindUnmarshaler interface shared by gin and echo, reading the enum from a
+// query, form or path parameter. The value is validated straight away if the enum has been
+// constructed, otherwise enum.Validate must be run afterwards, see enumgin and enumecho
+func (e *Enum) UnmarshalParam(s string) error {
+	return e.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,96 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"strings"
+	"unicode"
+)
+
+const ambiguousParseErrorMsg = "%s is ambiguous, it could be %s or %s"
+
+// Converts s into one of the enum's Consts, constructing the enum first if need be. The
+// enum's value is left untouched. Returns an error if s doesn't match any valid Const
+//   c, err := enum.Parse(new(CurrencyCodes), "usd", enum.CaseInsensitive())
+//   fmt.Println(c) // Prints "USD"
+func Parse(e Enummer, s string, opts ...Option) (Const, error) {
+	if err := ensureConstructed(e); err != nil {
+		return "", err
+	}
+	d := e.base().desc
+	if c, ok := d.resolve(s, newOptions(opts)); ok {
+		return c, nil
+	}
+	return "", d.invalid(Const(s))
+}
+
+// Converts messy, human entered s into one of the enum's Consts. Case, surrounding
+// whitespace and separators are ignored so "us-d", " usd " and "US_D" all parse as "USD".
+// Returns an error if s doesn't match any valid Const or matches more than one
+//   c, err := enum.ParseFuzzy(new(CurrencyCodes), " Us-D ")
+//   fmt.Println(c) // Prints "USD"
+func ParseFuzzy(e Enummer, s string, opts ...Option) (Const, error) {
+	if err := ensureConstructed(e); err != nil {
+		return "", err
+	}
+	d := e.base().desc
+	o := newOptions(opts)
+	if c, ok := d.resolve(s, o); ok {
+		return c, nil
+	}
+	key := fuzzyKey(s)
+	var match Const
+	for _, c := range d.table().consts {
+		if (c.retired && !o.lenient) || fuzzyKey(string(c.value)) != key {
+			continue
+		}
+		if match != "" {
+			return "", errors.New(fmt.Sprintf(ambiguousParseErrorMsg, s, match, c.value))
+		}
+		match = c.value
+	}
+	if match == "" {
+		return "", d.invalid(Const(s))
+	}
+	return match, nil
+}
+
+// s lower cased with whitespace and separators removed.
+func fuzzyKey(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '_' || r == '.' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
+
+// Finds the valid Const s refers to under the provided options.
+func (d *Descriptor) resolve(s string, o *options) (Const, bool) {
+	t := d.table()
+	if k := t.lookup(Const(s)); k != nil {
+		if k.retired && !o.lenient {
+			return "", false
+		}
+		return k.value, true
+	}
+	for _, c := range t.consts {
+		if c.retired && !o.lenient {
+			continue
+		}
+		if o.matches(c.value, s) {
+			return c.value, true
+		}
+		for _, a := range c.aliases {
+			if o.matches(a, s) {
+				return c.value, true
+			}
+		}
+		for _, l := range c.legacy {
+			if o.matches(l, s) {
+				return c.value, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,50 @@
+package enum
+
+import (
+	"strconv"
+)
+
+// Marshals the value as its name like protojson does, emitting the Const whose code is 0
+// when the enum has no value since that is what an unset proto3 enum reads as. Enums tagged
+// format:"proto" are numbered like those tagged format:"int", unmarshal from either the name
+// or the number and default to the screaming_snake case, so they can stand in for proto enums
+// behind grpc-gateway. Numbers unmarshalled before the enum is constructed are read by
+// Validate. Tag fields omitzero to leave the zero value out as proto3 does
+//   type OrderStatus struct {
+//     enum.Enum      `format:"proto"`
+//     Unspecified    enum.Const // UNSPECIFIED = 0
+//     PaymentPending enum.Const // PAYMENT_PENDING = 1
+//   }
+//
+//   out, _ := json.Marshal(order) // {"status":"PAYMENT_PENDING"}
+func (e Enum) marshalProto() ([]byte, error) {
+	c := e.val
+	if c == "" {
+		t := e.desc.table()
+		i := t.byCode(0)
+		if i < 0 {
+			return []byte("null"), nil
+		}
+		c = t.consts[i].value
+	}
+	if !e.desc.has(c) {
+		return nil, e.desc.invalid(c)
+	}
+	return []byte(strconv.Quote(string(c))), nil
+}
+
+// Whether the enum holds no value or, for enums tagged format:"proto", the Const whose code
+// is 0. Fields tagged omitzero are left out of JSON when this is true
+//   type Order struct {
+//     Status OrderStatus `json:"status,omitzero"`
+//   }
+func (e Enum) IsZero() bool {
+	if e.val == "" {
+		return true
+	}
+	if e.desc == nil || e.desc.format != protoFormat {
+		return false
+	}
+	code, ok := e.desc.Code(e.val)
+	return ok && code == 0
+}
@@ -0,0 +1,33 @@
+package enum
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+)
+
+const notEnumErrorMsg = "%s is not an enum"
+
+// Wraps an enum type so testing/quick generates it holding a random valid value. A method
+// on Enum can't build the struct embedding it, so the enum has to be wrapped instead
+//   f := func(a enum.Arbitrary[CurrencyCodes]) bool {
+//     return enum.Validate(&a.Value) == nil
+//   }
+//   err := quick.Check(f, nil)
+type Arbitrary[T any] struct {
+	Value T
+}
+
+// Implements quick.Generator. Panics if a pointer to T is not an Enummer
+func (Arbitrary[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	v := new(T)
+	e, ok := interface{}(v).(Enummer)
+	if !ok {
+		panic(fmt.Sprintf(notEnumErrorMsg, reflect.TypeOf(v).Elem()))
+	}
+	if err := construct(e); err != nil {
+		panic(err.Error())
+	}
+	e.unsafeSet(Random(e, r))
+	return reflect.ValueOf(Arbitrary[T]{Value: *v})
+}
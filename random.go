@@ -0,0 +1,34 @@
+package enum
+
+import (
+	"math/rand"
+)
+
+// Picks one of the enum's Consts at random using r, or the math/rand default source if r is
+// nil. Only Consts returned by GetAll are picked. Returns an empty Const if there is nothing
+// to pick from
+//   c := enum.Random(new(CurrencyCodes), rand.New(rand.NewSource(1)))
+func Random(e Enummer, r *rand.Rand) Const {
+	return RandomExcept(e, r)
+}
+
+// Picks one of the enum's Consts at random, never picking any of except
+//   c := enum.RandomExcept(cc, nil, cc.Get()) // Any currency other than the current one
+func RandomExcept(e Enummer, r *rand.Rand, except ...Const) Const {
+	if ensureConstructed(e) != nil {
+		return ""
+	}
+	var cs []Const
+	for _, c := range e.GetAll() {
+		if !contains(except, c) {
+			cs = append(cs, c)
+		}
+	}
+	if len(cs) == 0 {
+		return ""
+	}
+	if r == nil {
+		return cs[rand.Intn(len(cs))]
+	}
+	return cs[r.Intn(len(cs))]
+}
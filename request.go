@@ -0,0 +1,76 @@
+package enum
+
+import (
+	"github.com/pkg/errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const missingParamErrorMsg = "no value was provided"
+
+// Sets the enum from the path parameter param of a request routed by http.ServeMux, or any
+// router filling in r.PathValue. Errors name the parameter that caused them. See enumchi and
+// enummux for routers that keep parameters elsewhere
+//   mux.HandleFunc("GET /orders/{status}", func(w http.ResponseWriter, r *http.Request) {
+//     status := enum.New(new(OrderStatus)).(*OrderStatus)
+//     if err := enum.FromRequestPath(status, r, "status"); err != nil {
+//       http.Error(w, err.Error(), http.StatusBadRequest)
+//       return
+//     }
+//   })
+func FromRequestPath(e Enummer, r *http.Request, param string, opts ...Option) error {
+	return FromParam(e, param, r.PathValue(param), opts...)
+}
+
+// Sets the enum from s, the value of the parameter called name. Unlike FromEnv the parameter
+// is required so an empty s is an error. Errors name the parameter that caused them
+//   err := enum.FromParam(status, "status", chi.URLParam(r, "status"))
+func FromParam(e Enummer, name, s string, opts ...Option) error {
+	if s == "" {
+		return &FieldError{Field: name, Err: errors.New(missingParamErrorMsg)}
+	}
+	c, err := Parse(e, s, opts...)
+	if err == nil {
+		err = e.Set(c)
+	}
+	if err != nil {
+		return &FieldError{Field: name, Err: err}
+	}
+	return nil
+}
+
+// Sets the enum from the query parameter key. If key isn't in values the enum is left
+// untouched. Errors name the parameter that caused them
+//   err := enum.FromQuery(status, r.URL.Query(), "status")
+func FromQuery(e Enummer, values url.Values, key string, opts ...Option) error {
+	if _, ok := values[key]; !ok {
+		return nil
+	}
+	return FromParam(e, key, values.Get(key), opts...)
+}
+
+// Converts every value of the query parameter key into one of e's Consts, see ParseSlice.
+// The parameter may be repeated, hold a comma separated list or both, so ?status=ACTIVE,PENDING
+// and ?status=ACTIVE&status=PENDING are the same. Empty entries are skipped and nil is returned
+// if key isn't in values. Errors name the parameter and the index of the offending entry
+//   statuses, err := enum.FromQueryAll(new(OrderStatus), r.URL.Query(), "status")
+//   fmt.Println(statuses) // Prints [ACTIVE PENDING]
+func FromQueryAll(e Enummer, values url.Values, key string, opts ...Option) ([]Const, error) {
+	var ss []string
+	for _, v := range values[key] {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				ss = append(ss, s)
+			}
+		}
+	}
+	if len(ss) == 0 {
+		return nil, nil
+	}
+	out, err := ParseSlice(e, ss, opts...)
+	if err != nil {
+		return nil, &FieldError{Field: key, Err: err}
+	}
+	return out, nil
+}
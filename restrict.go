@@ -0,0 +1,77 @@
+package enum
+
+// Creates an enum which only accepts the allowed subset of e's Consts, so an endpoint that
+// takes some of an enum's values doesn't need its own enum struct. The restricted enum
+// holds e's current value if it is allowed. Returns an error if any allowed value isn't on e
+//   type Update struct {
+//     Status *enum.Enum `json:"status"`
+//   }
+//
+//   status, err := enum.Restrict(new(OrderStatus), "SHIPPED", "CANCELLED")
+//   u := Update{Status: status.(*enum.Enum)}
+//   json.Unmarshal(b, &u)
+//   err = enum.Validate(u.Status) // <-- PENDING and DELIVERED are rejected
+func Restrict(e Enummer, allowed ...Const) (Enummer, error) {
+	d, err := Describe(e)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := d.subset(allowed)
+	if err != nil {
+		return nil, err
+	}
+	out := &Enum{desc: sub}
+	if sub.has(e.Get()) {
+		out.val = e.Get()
+	}
+	return out, nil
+}
+
+// A Descriptor holding only the allowed Consts, in the order they were declared, which is
+// otherwise configured like d and uses its codec. Transitions to Consts outside of the subset
+// are dropped, as is the default if it isn't allowed.
+func (d *Descriptor) subset(allowed []Const) (*Descriptor, error) {
+	keep := make(map[Const]bool, len(allowed))
+	for _, c := range allowed {
+		if !d.has(d.canonical(c)) {
+			return nil, d.invalid(c)
+		}
+		keep[d.canonical(c)] = true
+	}
+	sub := &Descriptor{
+		name:           d.name,
+		format:         d.format,
+		xml:            d.xml,
+		extensible:     d.extensible,
+		ordered:        d.ordered,
+		loose:          d.loose,
+		restrictedFrom: d,
+	}
+	if keep[d.defaultVal] {
+		sub.defaultVal = d.defaultVal
+	}
+	var consts []constant
+	for _, c := range d.table().consts {
+		if keep[c.value] {
+			consts = append(consts, c)
+		}
+	}
+	sub.tab.Store(newConstTable(d.name, consts))
+	for from, next := range d.transitions {
+		if !keep[from] {
+			continue
+		}
+		if sub.transitions == nil {
+			sub.transitions = make(map[Const][]Const)
+		}
+		kept := make([]Const, 0)
+		for _, to := range next {
+			if keep[to] {
+				kept = append(kept, to)
+			}
+		}
+		sub.transitions[from] = kept
+	}
+	sub.indexTransitions()
+	return sub, nil
+}
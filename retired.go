@@ -0,0 +1,36 @@
+package enum
+
+const retiredWarningMsg = "%s is retired: %s"
+const retiredMarshalErrorMsg = "cannot marshal %s, it has been retired"
+
+// A list of the retired Consts on the enum. A retired Const is kept as a tombstone so values
+// already stored can still be read through Validate's Lenient option. It can't be set,
+// can't be marshalled and is left out of GetAll
+//   type CurrencyCodes struct {
+//     enum.Enum
+//     EUR enum.Const
+//     DEM enum.Const `retired:"replaced by EUR in 2002"`
+//   }
+func (e *Enum) Retired() []Const {
+	var out []Const
+	if e.desc == nil {
+		return out
+	}
+	for _, c := range e.desc.table().consts {
+		if c.retired {
+			out = append(out, c.value)
+		}
+	}
+	return out
+}
+
+// Whether the enum currently holds a retired value, which can only happen through a lenient
+// Validate
+func (e *Enum) IsRetired() bool {
+	return e.desc != nil && e.desc.retired(e.val)
+}
+
+func (d *Descriptor) retired(c Const) bool {
+	k := d.lookup(c)
+	return k != nil && k.retired
+}
@@ -0,0 +1,242 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"math/bits"
+	"reflect"
+	"strings"
+)
+
+const setMismatchErrorMsg = "cannot combine a set of %s with a set of %s"
+
+// A set of Consts from the enum type T, stored as a bitset over their ordinals. Like
+// Arbitrary, T is the enum struct itself. The zero value is an empty set ready to use.
+// Sets share their storage when copied, use Clone to get an independent copy
+//   type Filter struct {
+//     Statuses enum.Set[OrderStatus] `json:"statuses"` // Marshals as ["PENDING","SHIPPED"]
+//   }
+type Set[T any] struct {
+	desc *Descriptor
+	bits []uint64
+}
+
+// Creates a set holding the provided Consts. Returns an error if any of them can't be set on e
+//   s, err := enum.NewSet(new(OrderStatus), "PENDING", "SHIPPED")
+func NewSet[T any](e *T, cs ...Const) (Set[T], error) {
+	s := Set[T]{}
+	d, err := describeType[T](e)
+	if err != nil {
+		return s, err
+	}
+	s.desc = d
+	return s, s.Add(cs...)
+}
+
+// Creates a set holding every Const returned by GetAll. Panics if the enum is declared incorrectly
+//   s := enum.AllOf(new(OrderStatus))
+func AllOf[T any](e *T) Set[T] {
+	s, err := AllOfE(e)
+	if err != nil {
+		panic(err.Error())
+	}
+	return s
+}
+
+// Creates a set holding every Const returned by GetAll. Returns an error rather than
+// panicking if the enum is declared incorrectly
+//   s, err := enum.AllOfE(new(OrderStatus))
+func AllOfE[T any](e *T) (Set[T], error) {
+	s := Set[T]{}
+	d, err := describeType[T](e)
+	if err != nil {
+		return s, err
+	}
+	s.desc = d
+	for _, c := range d.listed() {
+		s.add(d.index(c))
+	}
+	return s, nil
+}
+
+// Adds the Consts to the set. Returns an error, leaving the set untouched, if any of them
+// can't be set on the enum
+func (s *Set[T]) Add(cs ...Const) error {
+	d, err := s.descriptor()
+	if err != nil {
+		return err
+	}
+	t := d.table()
+	ords := make([]int, len(cs))
+	for j, c := range cs {
+		if ords[j] = t.index(c); ords[j] < 0 || t.consts[ords[j]].retired {
+			return d.invalid(c)
+		}
+	}
+	for _, i := range ords {
+		s.add(i)
+	}
+	return nil
+}
+
+// Removes the Consts from the set, ignoring any that aren't in it
+func (s *Set[T]) Remove(cs ...Const) {
+	d, err := s.descriptor()
+	if err != nil {
+		return
+	}
+	for _, c := range cs {
+		if i := d.index(c); i >= 0 && i/64 < len(s.bits) {
+			s.bits[i/64] &^= 1 << uint(i%64)
+		}
+	}
+}
+
+// Whether c is in the set
+func (s Set[T]) Contains(c Const) bool {
+	d, err := s.descriptor()
+	if err != nil {
+		return false
+	}
+	i := d.index(c)
+	return i >= 0 && i/64 < len(s.bits) && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// The number of Consts in the set
+func (s Set[T]) Len() int {
+	n := 0
+	for _, w := range s.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// The Consts in the set in declaration order
+func (s Set[T]) Values() []Const {
+	out := make([]Const, 0, s.Len())
+	if s.desc == nil {
+		return out
+	}
+	t := s.desc.table()
+	for w, word := range s.bits {
+		for word != 0 {
+			i := w*64 + bits.TrailingZeros64(word)
+			out = append(out, t.consts[i].value)
+			word &= word - 1
+		}
+	}
+	return out
+}
+
+// A copy of the set which doesn't share storage with it
+func (s Set[T]) Clone() Set[T] {
+	return Set[T]{desc: s.desc, bits: append([]uint64(nil), s.bits...)}
+}
+
+// A new set holding the Consts in either set
+func (s Set[T]) Union(o Set[T]) Set[T] {
+	return s.combine(o, func(a, b uint64) uint64 { return a | b })
+}
+
+// A new set holding the Consts in both sets
+func (s Set[T]) Intersect(o Set[T]) Set[T] {
+	return s.combine(o, func(a, b uint64) uint64 { return a & b })
+}
+
+// A new set holding the Consts in s that aren't in o
+func (s Set[T]) Difference(o Set[T]) Set[T] {
+	return s.combine(o, func(a, b uint64) uint64 { return a &^ b })
+}
+
+func (s Set[T]) String() string {
+	vals := s.Values()
+	strs := make([]string, len(vals))
+	for i, c := range vals {
+		strs[i] = string(c)
+	}
+	return "[" + strings.Join(strs, " ") + "]"
+}
+
+// Marshals the set into a JSON array of its Consts in declaration order
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Values())
+}
+
+// Unmarshals a JSON array of strings into the set, replacing its contents. Unlike Enum,
+// every value is validated straight away since the set already knows its enum type
+func (s *Set[T]) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var vals []Const
+	if err := json.Unmarshal(b, &vals); err != nil {
+		return err
+	}
+	out := Set[T]{desc: s.desc}
+	if err := out.Add(vals...); err != nil {
+		return err
+	}
+	*s = out
+	return nil
+}
+
+func (s *Set[T]) descriptor() (*Descriptor, error) {
+	if s.desc == nil {
+		d, err := describeType[T](nil)
+		if err != nil {
+			return nil, err
+		}
+		s.desc = d
+	}
+	return s.desc, nil
+}
+
+func (s *Set[T]) add(i int) {
+	for len(s.bits) <= i/64 {
+		s.bits = append(s.bits, 0)
+	}
+	s.bits[i/64] |= 1 << uint(i%64)
+}
+
+func (s Set[T]) combine(o Set[T], op func(a, b uint64) uint64) Set[T] {
+	if s.desc != nil && o.desc != nil && s.desc != o.desc {
+		panic(fmt.Sprintf(setMismatchErrorMsg, s.desc.name, o.desc.name))
+	}
+	out := Set[T]{desc: s.desc}
+	if out.desc == nil {
+		out.desc = o.desc
+	}
+	n := len(s.bits)
+	if len(o.bits) > n {
+		n = len(o.bits)
+	}
+	out.bits = make([]uint64, n)
+	for i := range out.bits {
+		var a, b uint64
+		if i < len(s.bits) {
+			a = s.bits[i]
+		}
+		if i < len(o.bits) {
+			b = o.bits[i]
+		}
+		out.bits[i] = op(a, b)
+	}
+	return out
+}
+
+// The Descriptor of e if it has one, which is the case for enums declared from values,
+// otherwise the Descriptor of T.
+func describeType[T any](e *T) (*Descriptor, error) {
+	if e != nil {
+		en, ok := interface{}(e).(Enummer)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf(notEnumErrorMsg, reflect.TypeOf(e).Elem()))
+		}
+		return Describe(en)
+	}
+	if _, ok := interface{}(new(T)).(Enummer); !ok {
+		return nil, errors.New(fmt.Sprintf(notEnumErrorMsg, reflect.TypeOf(e).Elem()))
+	}
+	return describe(reflect.TypeOf(e).Elem())
+}
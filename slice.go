@@ -0,0 +1,47 @@
+package enum
+
+import (
+	"strconv"
+)
+
+// Validates every enum in es, which may hold enums or pointers to them. Every invalid enum is
+// reported in a *MultiError with each error wrapped in a FieldError naming its index
+//   err := enum.ValidateSlice(req.Currencies)
+//   fmt.Println(err.Error()) // Prints "[1]: Random is not a valid enum"
+func ValidateSlice[T any](es []T, opts ...Option) error {
+	return ValidateAll(es, opts...)
+}
+
+// Converts every string in ss into one of e's Consts, see Parse. Every string which can't be
+// parsed is reported in a *MultiError with each error wrapped in a FieldError naming its index
+//   cs, err := enum.ParseSlice(new(CurrencyCodes), []string{"USD", "usd"}, enum.CaseInsensitive())
+//   fmt.Println(cs) // Prints [USD USD]
+func ParseSlice(e Enummer, ss []string, opts ...Option) ([]Const, error) {
+	out := make([]Const, len(ss))
+	var errs []error
+	for i, s := range ss {
+		c, err := Parse(e, s, opts...)
+		if err != nil {
+			if _, ok := err.(*InvalidEnumError); !ok {
+				return nil, err
+			}
+			errs = append(errs, &FieldError{Field: "[" + strconv.Itoa(i) + "]", Err: err})
+			continue
+		}
+		out[i] = c
+	}
+	if len(errs) > 0 {
+		return nil, &MultiError{Errors: errs}
+	}
+	return out, nil
+}
+
+// The Consts as strings, in the same order
+//   fmt.Println(strings.Join(enum.Strings(cc.GetAll()), ", ")) // Prints "USD, CUSTOM"
+func Strings(cs []Const) []string {
+	out := make([]string, len(cs))
+	for i, c := range cs {
+		out[i] = string(c)
+	}
+	return out
+}
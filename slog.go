@@ -0,0 +1,38 @@
+package enum
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+var logGroup atomic.Bool
+
+// Implements slog.LogValuer so enums log as their value rather than as a struct. See
+// SetLogGroup to log the enum's type and ordinal along with it
+//   slog.Info("payment received", "currency", cc) // currency=USD
+func (e Enum) LogValue() slog.Value {
+	return logValue(e.desc, e.val)
+}
+
+// Implements slog.LogValuer, logging the Value the same way as its enum
+func (v Value) LogValue() slog.Value {
+	return logValue(v.desc, v.c)
+}
+
+// Makes enums log as a group holding their value, type and ordinal rather than the bare value
+//   enum.SetLogGroup(true)
+//   slog.Info("payment received", "currency", cc) // currency.value=USD currency.type=CurrencyCodes currency.ordinal=0
+func SetLogGroup(on bool) {
+	logGroup.Store(on)
+}
+
+func logValue(d *Descriptor, c Const) slog.Value {
+	if !logGroup.Load() || d == nil {
+		return slog.StringValue(string(c))
+	}
+	return slog.GroupValue(
+		slog.String("value", string(c)),
+		slog.String("type", d.name),
+		slog.Int("ordinal", d.index(c)),
+	)
+}
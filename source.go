@@ -0,0 +1,191 @@
+package enum
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const refreshFailedWarningMsg = "failed to refresh enum values of %s, keeping the previous values: %v"
+const sourceStatusErrorMsg = "fetching enum values from %s returned %s"
+
+// Somewhere the valid values of an enum can be loaded from at runtime, see LoadFrom. Load
+// should return every value each time it is called
+type Source interface {
+	Load(ctx context.Context) ([]Const, error)
+}
+
+// A Source built from a function
+//   src := enum.SourceFunc(func(ctx context.Context) ([]enum.Const, error) {
+//     return admin.ListRegions(ctx)
+//   })
+type SourceFunc func(ctx context.Context) ([]Const, error)
+
+func (f SourceFunc) Load(ctx context.Context) ([]Const, error) {
+	return f(ctx)
+}
+
+// A Source reading values from a file. Files ending in .json hold a JSON array of strings,
+// any other file holds one value per line with blank lines ignored
+//   src := enum.FileSource("/etc/app/regions.txt")
+func FileSource(path string) Source {
+	return SourceFunc(func(ctx context.Context) ([]Const, error) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if filepath.Ext(path) == ".json" {
+			return decodeValues(bytes.NewReader(b))
+		}
+		var out []Const
+		scanner := bufio.NewScanner(bytes.NewReader(b))
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				out = append(out, Const(line))
+			}
+		}
+		return out, scanner.Err()
+	})
+}
+
+// A Source fetching a JSON array of strings from url. Uses http.DefaultClient if client is nil
+//   src := enum.HTTPSource(nil, "https://config.internal/regions")
+func HTTPSource(client *http.Client, url string) Source {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return SourceFunc(func(ctx context.Context) ([]Const, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return nil, errors.New(fmt.Sprintf(sourceStatusErrorMsg, url, res.Status))
+		}
+		return decodeValues(res.Body)
+	})
+}
+
+// An enum whose valid values are loaded from a Source. The values are held in an immutable
+// snapshot which Refresh swaps atomically, so the enum can be validated and set while it is
+// being refreshed without ever seeing a partial set of values
+type RemoteEnum struct {
+	Enum
+	src      Source
+	ttl      time.Duration
+	loadedAt atomic.Int64
+	mu       sync.Mutex
+}
+
+// Creates an enum called name whose valid values are loaded from src. The enum starts out
+// empty. Returns an error if the values can't be loaded or one of them is empty or repeated.
+// Keep the values up to date with Refresh, Poll or the TTL option
+//   regions, err := enum.LoadFrom(ctx, "Region", enum.HTTPSource(nil, url))
+//   go regions.Poll(ctx, time.Minute)
+func LoadFrom(ctx context.Context, name string, src Source, opts ...Option) (*RemoteEnum, error) {
+	e := &RemoteEnum{src: src, ttl: newOptions(opts).ttl}
+	consts, err := e.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e.desc = &Descriptor{name: name}
+	e.desc.tab.Store(newConstTable(name, consts))
+	e.loadedAt.Store(time.Now().UnixNano())
+	return e, nil
+}
+
+// Reloads the valid values from the Source. The previous values are kept if they can't be
+// loaded or one of them is empty or repeated. The current value is left alone even if it is
+// no longer valid, use Validate to check it
+func (e *RemoteEnum) Refresh(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	consts, err := e.load(ctx)
+	if err != nil {
+		return err
+	}
+	e.desc.tab.Store(newConstTable(e.desc.name, consts))
+	e.loadedAt.Store(time.Now().UnixNano())
+	return nil
+}
+
+// Refreshes the values every interval until ctx is done. Failed refreshes are logged and the
+// previous values kept
+//   go regions.Poll(ctx, time.Minute)
+func (e *RemoteEnum) Poll(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.Refresh(ctx); err != nil && ctx.Err() == nil {
+				logf(refreshFailedWarningMsg, e.desc.name, err)
+			}
+		}
+	}
+}
+
+// Sets the value after refreshing the valid values if they are older than the TTL
+func (e *RemoteEnum) Set(c Const) error {
+	e.refreshIfStale()
+	return e.Enum.Set(c)
+}
+
+// Panics if the value can't be set, see Set
+func (e *RemoteEnum) MustSet(c Const) {
+	if err := e.Set(c); err != nil {
+		panic(err.Error())
+	}
+}
+
+// All valid values, refreshed first if they are older than the TTL
+func (e *RemoteEnum) GetAll() []Const {
+	e.refreshIfStale()
+	return e.Enum.GetAll()
+}
+
+// Refreshes the values if they have outlived the TTL. A failed refresh is logged rather
+// than returned since the previous values are still usable.
+func (e *RemoteEnum) refreshIfStale() {
+	if e.ttl <= 0 || time.Since(time.Unix(0, e.loadedAt.Load())) < e.ttl {
+		return
+	}
+	if err := e.Refresh(context.Background()); err != nil {
+		logf(refreshFailedWarningMsg, e.desc.name, err)
+	}
+}
+
+func (e *RemoteEnum) load(ctx context.Context) ([]constant, error) {
+	values, err := e.src.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newConstants(values)
+}
+
+func decodeValues(r io.Reader) ([]Const, error) {
+	var out []Const
+	if err := json.NewDecoder(r).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
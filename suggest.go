@@ -0,0 +1,63 @@
+package enum
+
+import (
+	"sort"
+	"strings"
+)
+
+// The most suggestions an InvalidEnumError carries.
+const maxSuggestions = 3
+
+// The listed Consts closest to c by edit distance, ignoring case. A Const is only suggested
+// if at most a quarter of c would need to change to reach it, and never more than 2 edits,
+// so short values are only matched when they differ by case.
+func (t *constTable) suggest(c Const) []Const {
+	target := strings.ToLower(string(c))
+	limit := len([]rune(target)) / 4
+	if limit > 2 {
+		limit = 2
+	}
+	type candidate struct {
+		value Const
+		dist  int
+	}
+	var found []candidate
+	for _, con := range t.consts {
+		if con.deprecated || con.retired {
+			continue
+		}
+		if dist := editDistance(target, strings.ToLower(string(con.value))); dist <= limit {
+			found = append(found, candidate{value: con.value, dist: dist})
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].dist < found[j].dist
+	})
+	var out []Const
+	for i := 0; i < len(found) && i < maxSuggestions; i++ {
+		out = append(out, found[i].value)
+	}
+	return out
+}
+
+// The Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
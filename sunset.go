@@ -0,0 +1,68 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"sync/atomic"
+	"time"
+)
+
+const sunsetLayout = "2006-01-02"
+const invalidSunsetErrorMsg = "sunset tag on %s must be a date like 2006-01-02 but got %q"
+const sunsetWarningMsg = "%s was sunset on %s"
+const sunsetErrorMsg = "%s was sunset on %s"
+
+// What happens when a Const is used after the date in its sunset tag
+type SunsetPolicy int32
+
+const (
+	// Sunset dates are informational only. The default
+	SunsetIgnore SunsetPolicy = iota
+	// Setting or validating a sunset Const logs a warning, see SetLogger
+	SunsetWarn
+	// Setting or validating a sunset Const returns an error. Validate's Lenient option
+	// downgrades the error to a warning so stored values can still be read
+	SunsetReject
+)
+
+var sunsetPolicy atomic.Int32
+
+// Sets what happens when a Const is set or validated on or after the date in its sunset tag,
+// which helps drive long running migrations off a value
+//   type Plans struct {
+//     enum.Enum
+//     Basic enum.Const `sunset:"2026-01-01"`
+//     Pro   enum.Const
+//   }
+//
+//   enum.SetSunsetPolicy(enum.SunsetReject)
+//   err := plan.Set("Basic") // Returns "Basic was sunset on 2026-01-01" from 2026 onwards
+func SetSunsetPolicy(p SunsetPolicy) {
+	sunsetPolicy.Store(int32(p))
+}
+
+// The date in c's sunset tag. Returns false if c has no sunset date
+func (e *Enum) Sunset(c Const) (time.Time, bool) {
+	if e.desc == nil {
+		return time.Time{}, false
+	}
+	k := e.desc.lookup(c)
+	if k == nil || k.sunset.IsZero() {
+		return time.Time{}, false
+	}
+	return k.sunset, true
+}
+
+// Applies the SunsetPolicy to the Const. Rejections are downgraded to warnings when lenient.
+func (k *constant) checkSunset(lenient bool) error {
+	policy := SunsetPolicy(sunsetPolicy.Load())
+	if policy == SunsetIgnore || k.sunset.IsZero() || time.Now().Before(k.sunset) {
+		return nil
+	}
+	date := k.sunset.Format(sunsetLayout)
+	if policy == SunsetReject && !lenient {
+		return errors.New(fmt.Sprintf(sunsetErrorMsg, k.value, date))
+	}
+	logf(sunsetWarningMsg, k.value, date)
+	return nil
+}
@@ -0,0 +1,144 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const unknownTagOptionErrorMsg = "enum tag on %s has unknown option %q"
+const tagOptionValueErrorMsg = "%s option on %s needs a value"
+const multipleDefaultsErrorMsg = "%s and %s are both marked default"
+const aliasClashErrorMsg = "alias %s of %s is already a value of the enum"
+const duplicateAliasErrorMsg = "alias %s is used by both %s and %s"
+
+// An option following the value in an enum tag, or one of the tags an option can be given as.
+type tagOption struct {
+	key   string
+	value string
+}
+
+// The tags which can also be given as options in the enum tag, applied in this order.
+var optionTags = []string{"display", "desc", "deprecated", "retired", "order", "sunset", "code", "group", "parent"}
+
+// Splits an enum tag into the Const's value and its options. The grammar is
+//   tag    = [value] {"," option}
+//   option = key ["=" text]
+// where key is one of default, alias, display, desc, deprecated, retired, order, sunset, code,
+// group or parent.
+// Neither the value nor the text of an option can contain a comma. alias may be repeated
+//   type CurrencyCodes struct {
+//     enum.Enum
+//     Custom enum.Const `enum:"CUSTOM,default,deprecated=use USD,alias=legacy_custom"`
+//     Euro   enum.Const `enum:",display=Euro"` // <-- the value is still "Euro"
+//   }
+func parseEnumTag(tag string) (string, []tagOption) {
+	parts := strings.Split(tag, ",")
+	var opts []tagOption
+	for _, p := range parts[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		opts = append(opts, tagOption{key: strings.TrimSpace(k), value: v})
+	}
+	return parts[0], opts
+}
+
+// Applies a tag option to the Const declared by the field called field.
+func (c *constant) apply(field string, o tagOption) error {
+	switch o.key {
+	case "default":
+		c.isDefault = true
+	case "alias":
+		if o.value == "" {
+			return errors.New(fmt.Sprintf(tagOptionValueErrorMsg, o.key, field))
+		}
+		c.aliases = append(c.aliases, Const(o.value))
+	case "display":
+		c.display = o.value
+	case "desc":
+		c.description = o.value
+	case "deprecated":
+		c.deprecated = true
+		c.deprecation = o.value
+		if c.deprecation == "" {
+			c.deprecation = "deprecated"
+		}
+	case "retired":
+		c.retired = true
+		c.retirement = o.value
+		if c.retirement == "" {
+			c.retirement = "retired"
+		}
+	case "order":
+		order, err := strconv.Atoi(o.value)
+		if err != nil {
+			return errors.New(fmt.Sprintf(invalidOrderErrorMsg, field, o.value))
+		}
+		c.order = order
+		c.ordered = true
+	case "sunset":
+		sunset, err := time.Parse(sunsetLayout, o.value)
+		if err != nil {
+			return errors.New(fmt.Sprintf(invalidSunsetErrorMsg, field, o.value))
+		}
+		c.sunset = sunset
+	case "code":
+		code, err := strconv.Atoi(o.value)
+		if err != nil {
+			return errors.New(fmt.Sprintf(invalidCodeErrorMsg, field, o.value))
+		}
+		c.code = code
+		c.coded = true
+	case "group":
+		c.group = o.value
+	case "parent":
+		if o.value == "" {
+			return errors.New(fmt.Sprintf(tagOptionValueErrorMsg, o.key, field))
+		}
+		c.parent = Const(o.value)
+	default:
+		return errors.New(fmt.Sprintf(unknownTagOptionErrorMsg, field, o.key))
+	}
+	return nil
+}
+
+// The Const marked default, or "" if there is none. Returns an error if more than one is.
+func defaultConst(consts []constant) (Const, error) {
+	var out *constant
+	for i, c := range consts {
+		if !c.isDefault {
+			continue
+		}
+		if out != nil {
+			return "", errors.New(fmt.Sprintf(multipleDefaultsErrorMsg, out.name, c.name))
+		}
+		out = &consts[i]
+	}
+	if out == nil {
+		return "", nil
+	}
+	return out.value, nil
+}
+
+// Checks that no alias is the value of another Const or an alias of two Consts, either of
+// which would make reading it ambiguous.
+func checkAliases(consts []constant) error {
+	values := make(map[Const]string, len(consts))
+	for _, c := range consts {
+		values[c.value] = c.name
+	}
+	seen := make(map[Const]string)
+	for _, c := range consts {
+		for _, a := range c.aliases {
+			if other, ok := values[a]; ok && other != c.name {
+				return errors.New(fmt.Sprintf(aliasClashErrorMsg, a, c.name))
+			}
+			if other, ok := seen[a]; ok && other != c.name {
+				return errors.New(fmt.Sprintf(duplicateAliasErrorMsg, a, other, c.name))
+			}
+			seen[a] = c.name
+		}
+	}
+	return nil
+}
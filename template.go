@@ -0,0 +1,53 @@
+package enum
+
+import (
+	"html/template"
+	"strings"
+)
+
+// Functions for html/template which render an enum as the options of a select input
+//   tmpl := template.Must(template.New("form").Funcs(enum.FuncMap()).Parse(
+//     `<select name="currency">{{enumOptions .Currency}}</select>`,
+//   ))
+//
+// or, to lay the options out by hand
+//   {{range .Currency.GetAll}}<option value="{{.}}" {{enumSelected $.Currency .}}>{{.}}</option>{{end}}
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"enumOptions":  OptionsHTML,
+		"enumSelected": SelectedAttr,
+	}
+}
+
+// An <option> for every Const which can be set on the enum, valued with the Const and labelled
+// with its display name. The enum's current value is selected. Deprecated Consts are left out
+// unless they are the current value, so existing records still render. Returns an error if the
+// enum is declared incorrectly
+//   enum.OptionsHTML(cc) // <option value="USD" selected>US Dollar</option><option value="EUR">Euro</option>
+func OptionsHTML(e Enummer) (template.HTML, error) {
+	d, err := Describe(e)
+	if err != nil {
+		return "", err
+	}
+	cur := e.Get()
+	var b strings.Builder
+	for _, c := range d.table().consts {
+		if c.retired || (c.deprecated && c.value != cur) {
+			continue
+		}
+		b.WriteString(`<option value="` + template.HTMLEscapeString(string(c.value)) + `"`)
+		if c.value == cur {
+			b.WriteString(" selected")
+		}
+		b.WriteString(">" + template.HTMLEscapeString(d.displayName(c.value)) + "</option>")
+	}
+	return template.HTML(b.String()), nil
+}
+
+// The selected attribute if c is the enum's current value, otherwise nothing
+func SelectedAttr(e Enummer, c Const) template.HTMLAttr {
+	if !isNil(e) && c != "" && e.Get() == c {
+		return "selected"
+	}
+	return ""
+}
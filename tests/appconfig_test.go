@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type appConfig struct {
+	Billing struct {
+		Currency CurrencyCode `json:"currency"`
+		Tagged   TaggedCurrency `json:"tagged"`
+	} `json:"billing"`
+	Fallback *CurrencyCode `json:"fallback"`
+	Regions  []Region      `json:"regions"`
+}
+
+func TestValidateConfig(t *testing.T) {
+	asrt := assert.New(t)
+
+	var cfg appConfig
+	asrt.Nil(json.Unmarshal([]byte(`{"billing":{"currency":"DIA"},"regions":["FR"]}`), &cfg))
+
+	asrt.Nil(enum.ValidateConfig(&cfg))
+	asrt.Equal(cfg.Billing.Tagged.USD, cfg.Billing.Tagged.Get())
+	asrt.Equal(cfg.Billing.Currency.DIA, cfg.Billing.Currency.Get())
+	asrt.Equal(cfg.Regions[0].France, cfg.Regions[0].Get())
+}
+
+func TestValidateConfigErrors(t *testing.T) {
+	asrt := assert.New(t)
+
+	var cfg appConfig
+	asrt.Nil(json.Unmarshal([]byte(`{"billing":{"currency":"USD"},"fallback":"DIA","regions":["FR","MARS"]}`), &cfg))
+
+	err := enum.ValidateConfig(&cfg)
+	asrt.EqualError(err, "billing.currency: USD is not a valid enum; regions[1]: MARS is not a valid enum")
+
+	invalid := enum.InvalidValuesIn(err)
+	asrt.Len(invalid, 2)
+	asrt.Equal("billing.currency", invalid[0].Field)
+}
+
+func TestValidateConfigEnv(t *testing.T) {
+	asrt := assert.New(t)
+
+	t.Setenv("APP_BILLING_CURRENCY", "ASd")
+	t.Setenv("APP_BILLING_TAGGED", "GBP")
+
+	var cfg appConfig
+	err := enum.ValidateConfig(&cfg, enum.Env("APP"))
+	asrt.EqualError(err, "APP_BILLING_TAGGED: GBP is not a valid enum")
+	asrt.Equal(cfg.Billing.Currency.USD, cfg.Billing.Currency.Get())
+	asrt.Equal(cfg.Billing.Tagged.USD, cfg.Billing.Tagged.Get())
+}
@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestAudited(t *testing.T) {
+	asrt := assert.New(t)
+
+	var records []enum.AuditRecord
+	sink := enum.AuditSinkFunc(func(ctx context.Context, r enum.AuditRecord) error {
+		records = append(records, r)
+		return nil
+	})
+	o := enum.MustConstruct(new(OrderStatus), "PENDING").(*OrderStatus)
+	status := enum.NewAudited(o, sink)
+	asrt.Same(o, status.Enum())
+
+	ctx := enum.WithActor(context.Background(), "alice")
+	asrt.Nil(status.Set(ctx, o.Shipped))
+	asrt.Nil(status.Set(context.Background(), o.Delivered))
+	asrt.NotNil(status.Set(ctx, o.Pending))
+	asrt.Equal(o.Delivered, status.Get())
+
+	asrt.Len(records, 2)
+	asrt.Equal("OrderStatus", records[0].Type)
+	asrt.Equal(o.Pending, records[0].Old)
+	asrt.Equal(o.Shipped, records[0].New)
+	asrt.Equal("alice", records[0].Actor)
+	asrt.False(records[0].At.IsZero())
+	asrt.Equal(o.Shipped, records[1].Old)
+	asrt.Equal(o.Delivered, records[1].New)
+	asrt.Equal("", records[1].Actor)
+}
+
+func TestAuditedAlias(t *testing.T) {
+	asrt := assert.New(t)
+
+	var records []enum.AuditRecord
+	sink := enum.AuditSinkFunc(func(ctx context.Context, r enum.AuditRecord) error {
+		records = append(records, r)
+		return nil
+	})
+	c := enum.MustConstruct(new(TaggedCurrency), "CUSTOM").(*TaggedCurrency)
+
+	asrt.Nil(enum.NewAudited(c, sink).Set(context.Background(), "dollar"))
+	asrt.Len(records, 1)
+	asrt.Equal(c.Custom, records[0].Old)
+	asrt.Equal(c.USD, records[0].New)
+}
+
+func TestAuditedSinkError(t *testing.T) {
+	asrt := assert.New(t)
+
+	sink := enum.AuditSinkFunc(func(ctx context.Context, r enum.AuditRecord) error {
+		return errors.New("sink unavailable")
+	})
+	o := enum.MustConstruct(new(OrderStatus), "PENDING").(*OrderStatus)
+
+	asrt.EqualError(enum.NewAudited(o, sink).Set(context.Background(), o.Shipped), "sink unavailable")
+	asrt.Equal(o.Shipped, o.Get())
+}
@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumavro"
+	"testing"
+)
+
+func TestAvroSchema(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.JSONEq(`{"type":"enum","name":"Tender","symbols":["CASH","CARD","CHEQUE"]}`, enum.AvroSchema(new(Tender)))
+}
+
+func TestAvroRoundTrip(t *testing.T) {
+	asrt := assert.New(t)
+
+	card := enum.MustConstruct(new(Tender), "CARD").(*Tender)
+	b, err := enumavro.Marshal(card)
+	asrt.Nil(err)
+	asrt.Equal([]byte{2}, b)
+
+	var out Tender
+	asrt.Nil(enumavro.Unmarshal(&out, b))
+	asrt.Equal(enum.Const("CARD"), out.Get())
+
+	asrt.Nil(enumavro.Unmarshal(&out, []byte{4}))
+	asrt.True(out.IsRetired())
+	_, err = enumavro.Marshal(&out)
+	asrt.EqualError(err, "CHEQUE has been retired")
+}
+
+func TestAvroInvalidSymbol(t *testing.T) {
+	asrt := assert.New(t)
+
+	type Spaced struct {
+		enum.Enum
+		A enum.Const `enum:"not valid"`
+	}
+
+	_, err := enumavro.Schema(new(Spaced))
+	asrt.EqualError(err, "not valid of Spaced is not a valid Avro symbol, which must match [A-Za-z_][A-Za-z0-9_]*")
+
+	_, err = enum.AvroSchemaE(new(Spaced))
+	asrt.EqualError(err, "not valid of Spaced is not a valid Avro symbol, which must match [A-Za-z_][A-Za-z0-9_]*")
+	asrt.Panics(func() { enum.AvroSchema(new(Spaced)) })
+}
@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"encoding"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+var _ encoding.BinaryMarshaler = enum.Enum{}
+var _ encoding.BinaryUnmarshaler = new(enum.Enum)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := enum.MustConstruct(new(Month), "December").(*Month)
+	b, err := m.MarshalBinary()
+	asrt.Nil(err)
+	asrt.Equal([]byte{12}, b)
+
+	out := enum.New(new(Month)).(*Month)
+	asrt.Nil(out.UnmarshalBinary(b))
+	asrt.Equal(out.December, out.Get())
+
+	b, err = enum.New(new(Month)).(*Month).MarshalBinary()
+	asrt.Nil(err)
+	asrt.Nil(out.UnmarshalBinary(b))
+	asrt.Equal(enum.Const(""), out.Get())
+}
+
+func TestBinaryErrors(t *testing.T) {
+	asrt := assert.New(t)
+
+	var m Month
+	_, err := m.MarshalBinary()
+	asrt.EqualError(err, "cannot use the binary encoding on an enum that has not been constructed")
+	asrt.EqualError(m.UnmarshalBinary([]byte{1}), "cannot use the binary encoding on an enum that has not been constructed")
+
+	tender := enum.New(new(Tender)).(*Tender)
+	asrt.EqualError(tender.UnmarshalBinary([]byte{9}), "ordinal 8 is out of range for Tender")
+	asrt.EqualError(tender.UnmarshalBinary([]byte{1, 1}), "malformed binary enum")
+	asrt.EqualError(tender.UnmarshalBinary(nil), "malformed binary enum")
+
+	asrt.Nil(tender.UnmarshalBinary([]byte{3}))
+	asrt.True(tender.IsRetired())
+	_, err = tender.MarshalBinary()
+	asrt.EqualError(err, "CHEQUE has been retired")
+}
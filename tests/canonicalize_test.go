@@ -0,0 +1,55 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestSetStoresCanonical(t *testing.T) {
+	asrt := assert.New(t)
+
+	tc := enum.New(new(TaggedCurrency)).(*TaggedCurrency)
+	asrt.Nil(tc.Set("dollar"))
+	asrt.Equal(tc.USD, tc.Get())
+	asrt.Equal("USD", tc.String())
+
+	rc := enum.New(new(RenamedCurrency)).(*RenamedCurrency)
+	asrt.Nil(rc.Set("US_DOLLAR"))
+	asrt.Equal(rc.USD, rc.Get())
+
+	out, err := json.Marshal(rc)
+	asrt.Nil(err)
+	asrt.Equal(`"USD"`, string(out))
+}
+
+func TestUnmarshalStoresCanonical(t *testing.T) {
+	asrt := assert.New(t)
+
+	tc := enum.New(new(TaggedCurrency)).(*TaggedCurrency)
+	asrt.Nil(json.Unmarshal([]byte(`"usd_legacy"`), tc))
+	asrt.Equal(tc.USD, tc.Get())
+	asrt.Nil(enum.Validate(tc))
+
+	var unconstructed TaggedCurrency
+	asrt.Nil(json.Unmarshal([]byte(`"dollar"`), &unconstructed))
+	asrt.Nil(enum.Validate(&unconstructed))
+	asrt.Equal(unconstructed.USD, unconstructed.Get())
+}
+
+func TestCanonicalize(t *testing.T) {
+	asrt := assert.New(t)
+
+	tc := enum.New(new(TaggedCurrency)).(*TaggedCurrency)
+	asrt.Nil(json.Unmarshal([]byte(`"Dollar"`), tc))
+	asrt.Equal(enum.Const("Dollar"), tc.Get())
+	asrt.NotNil(enum.Canonicalize(tc))
+	asrt.Nil(enum.Canonicalize(tc, enum.CaseInsensitive()))
+	asrt.Equal(tc.USD, tc.Get())
+
+	asrt.Nil(enum.Canonicalize(enum.New(new(CurrencyCode))))
+
+	asrt.Nil(json.Unmarshal([]byte(`"Random"`), tc))
+	asrt.EqualError(enum.Canonicalize(tc, enum.CaseInsensitive()), "Random is not a valid enum")
+}
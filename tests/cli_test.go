@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+	"go-enum"
+	"go-enum/enumcli"
+	"testing"
+)
+
+func cliApp(cc *CurrencyCode) *cli.App {
+	return &cli.App{
+		Name:   "pay",
+		Flags:  []cli.Flag{enumcli.EnumFlag("currency", "the currency to charge in", cc)},
+		Action: func(*cli.Context) error { return nil },
+		Writer: new(bytes.Buffer),
+	}
+}
+
+func TestCLIEnumFlag(t *testing.T) {
+	asrt := assert.New(t)
+
+	cc := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	asrt.Nil(cliApp(cc).Run([]string{"pay", "--currency", "DIA"}))
+	asrt.Equal(cc.DIA, cc.Get())
+
+	app := cliApp(enum.New(new(CurrencyCode)).(*CurrencyCode))
+	app.ErrWriter = new(bytes.Buffer)
+	err := app.Run([]string{"pay", "--currency", "USD"})
+	asrt.ErrorContains(err, "USD is not a valid enum")
+
+	f := enumcli.EnumFlag("currency", "the currency to charge in", cc)
+	asrt.Equal("the currency to charge in (one of ASd, DIA)", f.Usage)
+}
+
+func TestCLIGeneric(t *testing.T) {
+	asrt := assert.New(t)
+
+	cc := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	g := enumcli.Generic(cc)
+	asrt.Nil(g.Set("ASd"))
+	asrt.Equal("ASd", g.String())
+	asrt.NotNil(g.Set("USD"))
+}
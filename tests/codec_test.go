@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type LegacyCode struct {
+	enum.Enum
+	USD enum.Const
+	EUR enum.Const
+}
+
+type isoNumeric struct{}
+
+var isoCodes = map[enum.Const]string{"USD": "840", "EUR": "978"}
+
+func (isoNumeric) Encode(c enum.Const) ([]byte, error) {
+	code, ok := isoCodes[c]
+	if !ok {
+		return nil, errors.New("no numeric code for " + string(c))
+	}
+	return []byte(code), nil
+}
+
+func (isoNumeric) Decode(b []byte) (enum.Const, error) {
+	for c, code := range isoCodes {
+		if code == string(b) {
+			return c, nil
+		}
+	}
+	return "", errors.New("unknown numeric code " + string(b))
+}
+
+type legacyPayment struct {
+	Currency LegacyCode `json:"currency"`
+}
+
+func TestCodec(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Nil(enum.RegisterCodec(new(LegacyCode), isoNumeric{}))
+	defer enum.RegisterCodec(new(LegacyCode), nil)
+
+	c := enum.MustConstruct(new(LegacyCode), "EUR").(*LegacyCode)
+	b, err := json.Marshal(c)
+	asrt.Nil(err)
+	asrt.Equal(`"978"`, string(b))
+
+	b, err = c.MarshalText()
+	asrt.Nil(err)
+	asrt.Equal("978", string(b))
+
+	asrt.Nil(json.Unmarshal([]byte(`"840"`), c))
+	asrt.Equal(c.USD, c.Get())
+	asrt.EqualError(json.Unmarshal([]byte(`"123"`), c), "unknown numeric code 123")
+
+	asrt.Nil(c.UnmarshalText([]byte("978")))
+	asrt.Equal(c.EUR, c.Get())
+
+	var p legacyPayment
+	asrt.Nil(json.Unmarshal([]byte(`{"currency":"840"}`), &p))
+	asrt.Nil(enum.Validate(&p.Currency))
+	asrt.Equal(p.Currency.USD, p.Currency.Get())
+}
+
+func TestCodecRemoved(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Nil(enum.RegisterCodec(new(LegacyCode), isoNumeric{}))
+	asrt.Nil(enum.RegisterCodec(new(LegacyCode), nil))
+
+	c := enum.MustConstruct(new(LegacyCode), "EUR").(*LegacyCode)
+	b, err := json.Marshal(c)
+	asrt.Nil(err)
+	asrt.Equal(`"EUR"`, string(b))
+}
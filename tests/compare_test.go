@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type Tier struct {
+	enum.Enum `ordered:""`
+	Gold      enum.Const `enum:"GOLD" order:"3"`
+	Bronze    enum.Const `enum:"BRONZE,alias=basic" order:"1"`
+	Silver    enum.Const `enum:"SILVER" order:"2"`
+}
+
+func TestCompare(t *testing.T) {
+	asrt := assert.New(t)
+
+	s := enum.New(new(Tier)).(*Tier)
+
+	asrt.Equal(-1, s.Compare(s.Bronze, s.Gold))
+	asrt.Equal(1, s.Compare(s.Gold, s.Silver))
+	asrt.Equal(0, s.Compare(s.Silver, s.Silver))
+	asrt.True(s.Less(s.Bronze, s.Silver))
+	asrt.False(s.Less(s.Silver, s.Silver))
+	asrt.True(s.LessEqual(s.Silver, s.Silver))
+	asrt.True(s.Greater(s.Gold, s.Bronze))
+
+	asrt.Equal(s.Gold, s.Max(s.Bronze, s.Gold, s.Silver))
+	asrt.Equal(s.Bronze, s.Min(s.Silver, s.Bronze, s.Gold))
+	asrt.Equal(enum.Const(""), s.Max())
+}
+
+func TestCompareAliases(t *testing.T) {
+	asrt := assert.New(t)
+
+	s := enum.MustConstruct(new(Tier), "BRONZE").(*Tier)
+
+	asrt.True(s.Less("basic", s.Silver))
+	asrt.Equal(0, s.Compare("basic", s.Bronze))
+
+	ok, err := s.Between("basic", s.Bronze)
+	asrt.Nil(err)
+	asrt.True(ok)
+}
+
+func TestCompareInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	s := enum.New(new(Tier)).(*Tier)
+	asrt.PanicsWithValue("PLATINUM is not a valid enum", func() { s.Less("PLATINUM", s.Bronze) })
+
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	asrt.PanicsWithValue("CurrencyCode is not ordered, tag its enum.Enum with ordered to compare its values", func() {
+		c.Less(c.USD, c.DIA)
+	})
+
+	var unconstructed Tier
+	asrt.Panics(func() { unconstructed.Compare("BRONZE", "GOLD") })
+}
+
+func TestBetween(t *testing.T) {
+	asrt := assert.New(t)
+
+	s := enum.MustConstruct(new(Tier), "SILVER").(*Tier)
+
+	ok, err := s.Between(s.Bronze, s.Gold)
+	asrt.Nil(err)
+	asrt.True(ok)
+
+	ok, err = s.Between(s.Silver, s.Silver)
+	asrt.Nil(err)
+	asrt.True(ok)
+
+	ok, err = s.Between(s.Gold, s.Gold)
+	asrt.Nil(err)
+	asrt.False(ok)
+
+	ok, err = enum.New(new(Tier)).(*Tier).Between(s.Bronze, s.Gold)
+	asrt.Nil(err)
+	asrt.False(ok)
+
+	_, err = s.Between(s.Bronze, "PLATINUM")
+	asrt.EqualError(err, "PLATINUM is not a valid enum")
+
+	c := enum.MustConstruct(new(CurrencyCode), "DIA").(*CurrencyCode)
+	_, err = c.Between(c.USD, c.DIA)
+	asrt.EqualError(err, "CurrencyCode is not ordered, tag its enum.Enum with ordered to compare its values")
+}
@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestCompleteMap(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Nil(enum.CompleteMap(new(CurrencyCode), map[enum.Const]int{"ASd": 1, "DIA": 2}))
+
+	err := enum.CompleteMap(new(CurrencyCode), map[enum.Const]int{"ASd": 1})
+	asrt.EqualError(err, "map for CurrencyCode is missing entries for DIA")
+
+	err = enum.CompleteMap(new(CurrencyCode), map[enum.Const]int{"ASd": 1, "DIA": 2, "Random": 3})
+	asrt.EqualError(err, "Random is not a valid enum")
+}
+
+func TestCompleteMapRetired(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Nil(enum.CompleteMap(new(Tender), map[enum.Const]string{"CASH": "Cash", "CARD": "Card"}))
+
+	err := enum.CompleteMap(new(Tender), map[enum.Const]string{"CASH": "Cash", "CARD": "Card", "CHEQUE": "Cheque"})
+	asrt.EqualError(err, "CHEQUE has been retired")
+}
+
+func TestMustCompleteMap(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := enum.MustCompleteMap(new(CurrencyCode), map[enum.Const]bool{"ASd": true, "DIA": false})
+	asrt.Len(m, 2)
+
+	asrt.PanicsWithValue("map for CurrencyCode is missing entries for ASd, DIA", func() {
+		enum.MustCompleteMap(new(CurrencyCode), map[enum.Const]bool{})
+	})
+}
@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type ShellCurrency struct {
+	enum.Enum
+	USD enum.Const `display:"US Dollar"`
+	EUR enum.Const `desc:"The euro, it's used in the eurozone"`
+	CAD enum.Const
+	DEM enum.Const `deprecated:"use EUR"`
+	FRF enum.Const `retired:""`
+}
+
+type ShellTender struct {
+	enum.Enum
+	Cash     enum.Const `enum:"cash"`
+	GiftCard enum.Const `enum:"gift card"`
+}
+
+func TestBashCompletion(t *testing.T) {
+	asrt := assert.New(t)
+
+	script, err := enum.BashCompletion("pay-cli", map[string]enum.Enummer{
+		"--currency": new(ShellCurrency),
+		"tender":     new(ShellTender),
+	})
+	asrt.Nil(err)
+	asrt.Equal(`_pay_cli() {
+  local cur="${COMP_WORDS[COMP_CWORD]}" prev="${COMP_WORDS[COMP_CWORD-1]}"
+  case "$prev" in
+    '--currency')
+      mapfile -t COMPREPLY < <(compgen -W 'USD EUR CAD' -- "$cur")
+      ;;
+    '--tender')
+      mapfile -t COMPREPLY < <(compgen -W 'cash gift\ card' -- "$cur")
+      ;;
+  esac
+}
+complete -o default -F _pay_cli 'pay-cli'
+`, script)
+}
+
+func TestZshCompletion(t *testing.T) {
+	asrt := assert.New(t)
+
+	script, err := enum.ZshCompletion("pay", map[string]enum.Enummer{
+		"currency": new(ShellCurrency),
+		"tender":   new(ShellTender),
+	})
+	asrt.Nil(err)
+	asrt.Equal(`#compdef pay
+_arguments \
+  '--currency=[ShellCurrency]:currency:((USD\:"US Dollar" EUR\:"The euro, it'\''s used in the eurozone" CAD))' \
+  '--tender=[ShellTender]:tender:((cash gift\ card))'
+`, script)
+}
+
+func TestFishCompletion(t *testing.T) {
+	asrt := assert.New(t)
+
+	script, err := enum.FishCompletion("pay", map[string]enum.Enummer{
+		"currency": new(ShellCurrency),
+		"tender":   new(ShellTender),
+	})
+	asrt.Nil(err)
+	asrt.Equal(`complete -c 'pay' -l 'currency' -x -a 'USD' -d 'US Dollar'
+complete -c 'pay' -l 'currency' -x -a 'EUR' -d 'The euro, it'\''s used in the eurozone'
+complete -c 'pay' -l 'currency' -x -a 'CAD'
+complete -c 'pay' -l 'tender' -x -a 'cash'
+complete -c 'pay' -l 'tender' -x -a 'gift card'
+`, script)
+}
+
+func TestCompletionInvalidEnum(t *testing.T) {
+	asrt := assert.New(t)
+
+	for _, complete := range []func(string, map[string]enum.Enummer) (string, error){
+		enum.BashCompletion, enum.ZshCompletion, enum.FishCompletion,
+	} {
+		_, err := complete("pay", map[string]enum.Enummer{"currency": new(duplicateCode)})
+		asrt.Error(err)
+	}
+}
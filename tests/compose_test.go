@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type FiatCurrencies struct {
+	enum.Enum
+	USD enum.Const
+	EUR enum.Const `display:"Euro"`
+}
+
+type CryptoCurrencies struct {
+	enum.Enum `case:"lower"`
+	BTC       enum.Const
+	ETH       enum.Const
+}
+
+type PaymentCurrencies struct {
+	enum.Enum
+	FiatCurrencies
+	CryptoCurrencies
+	Points enum.Const `enum:"POINTS"`
+}
+
+func TestComposedEnum(t *testing.T) {
+	asrt := assert.New(t)
+
+	p := enum.New(new(PaymentCurrencies)).(*PaymentCurrencies)
+
+	asrt.Equal([]enum.Const{"USD", "EUR", "btc", "eth", "POINTS"}, p.GetAll())
+	asrt.Equal(enum.Const("USD"), p.USD)
+	asrt.Equal(enum.Const("btc"), p.BTC)
+
+	asrt.Nil(p.Set(p.ETH))
+	asrt.Equal(enum.Const("eth"), p.Get())
+	asrt.Equal(enum.Const(""), p.FiatCurrencies.Get())
+}
+
+func TestComposedDescriptor(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, err := enum.Describe(new(PaymentCurrencies))
+	asrt.Nil(err)
+
+	c, ok := d.Lookup("EUR")
+	asrt.True(ok)
+	asrt.Equal("Euro", c.Display)
+	asrt.Equal(1, c.Ordinal)
+}
+
+func TestComposedValidate(t *testing.T) {
+	asrt := assert.New(t)
+
+	var p PaymentCurrencies
+	asrt.Nil(p.UnmarshalJSON([]byte(`"EUR"`)))
+	asrt.Nil(enum.Validate(&p))
+
+	asrt.Nil(p.UnmarshalJSON([]byte(`"DOGE"`)))
+	asrt.EqualError(enum.Validate(&p), "DOGE is not a valid enum")
+}
@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+	"unsafe"
+)
+
+func TestCopyOnReadGetAll(t *testing.T) {
+	asrt := assert.New(t)
+
+	enum.SetCopyOnRead(true)
+	defer enum.SetCopyOnRead(false)
+
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	all := c.GetAll()
+	all[0] = "garbage"
+
+	asrt.Equal([]enum.Const{"ASd", "DIA"}, c.GetAll())
+}
+
+func TestCopyOnReadGetCanonical(t *testing.T) {
+	asrt := assert.New(t)
+
+	enum.SetCopyOnRead(true)
+	defer enum.SetCopyOnRead(false)
+
+	var c CurrencyCode
+	asrt.Nil(json.Unmarshal([]byte(`"DIA"`), &c))
+	asrt.Nil(enum.Validate(&c))
+
+	asrt.Equal(unsafe.StringData(string(c.DIA)), unsafe.StringData(string(c.Get())))
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"github.com/gocarina/gocsv"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type CSVPayment struct {
+	ID     string `csv:"id"`
+	Tender Tender `csv:"tender"`
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	asrt := assert.New(t)
+
+	rows := []*CSVPayment{
+		{ID: "1", Tender: *enum.MustConstruct(new(Tender), "CASH").(*Tender)},
+		{ID: "2", Tender: *enum.MustConstruct(new(Tender), "CARD").(*Tender)},
+	}
+	out, err := gocsv.MarshalString(&rows)
+	asrt.Nil(err)
+	asrt.Equal("id,tender\n1,CASH\n2,CARD\n", out)
+
+	var in []*CSVPayment
+	asrt.Nil(gocsv.UnmarshalString(out, &in))
+	asrt.Len(in, 2)
+	asrt.Nil(enum.Validate(&in[1].Tender))
+	asrt.Equal(enum.Const("CARD"), in[1].Tender.Get())
+}
+
+func TestCSVUnmarshalInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	var in []*CSVPayment
+	asrt.Nil(gocsv.UnmarshalString("id,tender\n1,IOU\n", &in))
+	asrt.EqualError(enum.Validate(&in[0].Tender), "IOU is not a valid enum")
+
+	tender := enum.New(new(Tender)).(*Tender)
+	asrt.EqualError(tender.UnmarshalCSV("IOU"), "IOU is not a valid enum")
+	asrt.EqualError(tender.UnmarshalCSV("CHEQUE"), "CHEQUE has been retired")
+	asrt.Nil(tender.UnmarshalCSV("CASH"))
+	asrt.Equal(tender.Cash, tender.Get())
+}
+
+func TestCSVMarshalRetired(t *testing.T) {
+	asrt := assert.New(t)
+
+	var tender Tender
+	asrt.Nil(tender.UnmarshalJSON([]byte(`"CHEQUE"`)))
+	asrt.Nil(enum.Validate(&tender, enum.Lenient()))
+
+	_, err := gocsv.MarshalString([]*CSVPayment{{ID: "1", Tender: tender}})
+	asrt.ErrorContains(err, "cannot marshal CHEQUE, it has been retired")
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	_ "github.com/glebarez/go-sqlite"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+	"time"
+)
+
+func tiersDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	_, err = db.Exec(`CREATE TABLE tiers (code TEXT); INSERT INTO tiers VALUES ('FREE'), ('PRO')`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestLoadFromDB(t *testing.T) {
+	asrt := assert.New(t)
+
+	ctx := context.Background()
+	db := tiersDB(t)
+	tiers, err := enum.LoadFromDB(ctx, "Tier", db, "SELECT code FROM tiers")
+	asrt.Nil(err)
+
+	d, err := enum.Describe(tiers)
+	asrt.Nil(err)
+	asrt.Equal("Tier", d.Name())
+	asrt.Equal([]enum.Const{"FREE", "PRO"}, tiers.GetAll())
+	asrt.Nil(tiers.Set("PRO"))
+	asrt.EqualError(tiers.Set("ENTERPRISE"), "ENTERPRISE is not a valid enum")
+
+	_, err = db.Exec(`INSERT INTO tiers VALUES ('ENTERPRISE')`)
+	asrt.Nil(err)
+	asrt.EqualError(tiers.Set("ENTERPRISE"), "ENTERPRISE is not a valid enum")
+
+	asrt.Nil(tiers.Refresh(ctx))
+	asrt.Nil(tiers.Set("ENTERPRISE"))
+	asrt.Nil(enum.Validate(tiers))
+}
+
+func TestLoadFromDBTTL(t *testing.T) {
+	asrt := assert.New(t)
+
+	db := tiersDB(t)
+	tiers, err := enum.LoadFromDB(context.Background(), "Tier", db, "SELECT code FROM tiers", enum.TTL(time.Millisecond))
+	asrt.Nil(err)
+
+	_, err = db.Exec(`INSERT INTO tiers VALUES ('ENTERPRISE')`)
+	asrt.Nil(err)
+	time.Sleep(5 * time.Millisecond)
+
+	asrt.Equal([]enum.Const{"FREE", "PRO", "ENTERPRISE"}, tiers.GetAll())
+	asrt.Nil(tiers.Set("ENTERPRISE"))
+}
+
+func TestLoadFromDBInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	ctx := context.Background()
+	db := tiersDB(t)
+
+	_, err := enum.LoadFromDB(ctx, "Tier", db, "SELECT code FROM missing")
+	asrt.NotNil(err)
+
+	tiers, err := enum.LoadFromDB(ctx, "Tier", db, "SELECT code FROM tiers")
+	asrt.Nil(err)
+	_, err = db.Exec(`INSERT INTO tiers VALUES ('PRO')`)
+	asrt.Nil(err)
+	asrt.EqualError(tiers.Refresh(ctx), "PRO is declared more than once")
+	asrt.Equal([]enum.Const{"FREE", "PRO"}, tiers.GetAll())
+}
@@ -0,0 +1,55 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"strings"
+	"testing"
+)
+
+type decodeTest struct {
+	CurrencyCode CurrencyCode            `json:"currency_code"`
+	Nested       *decodeTest             `json:"nested"`
+	History      []CurrencyCode          `json:"history"`
+	ByRegion     map[string]CurrencyCode `json:"by_region"`
+}
+
+func TestDecodeStrict(t *testing.T) {
+	asrt := assert.New(t)
+
+	var v decodeTest
+	err := enum.DecodeStrict(strings.NewReader(`{"currency_code":"ASd","history":["DIA"],"by_region":{"eu":"ASd"}}`), &v)
+
+	asrt.Nil(err)
+	asrt.Equal(v.CurrencyCode.USD, v.CurrencyCode.Get())
+	asrt.Equal(v.CurrencyCode.DIA, v.History[0].Get())
+	asrt.Equal(enum.Const("ASd"), v.ByRegion["eu"].USD)
+}
+
+func TestDecodeStrictInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	var v decodeTest
+	err := enum.DecodeStrict(strings.NewReader(`{"currency_code":"ASd","nested":{"currency_code":"USD"}}`), &v)
+
+	asrt.Equal("nested.currency_code: USD is not a valid enum", err.Error())
+	asrt.IsType(new(enum.MultiError), err)
+}
+
+func TestDecodeStrictInvalidSliceElement(t *testing.T) {
+	asrt := assert.New(t)
+
+	var v decodeTest
+	err := enum.DecodeStrict(strings.NewReader(`{"currency_code":"ASd","history":["DIA","EUR"]}`), &v)
+
+	asrt.Equal("history[1]: EUR is not a valid enum", err.Error())
+}
+
+func TestDecoderSingleEnum(t *testing.T) {
+	asrt := assert.New(t)
+
+	var c CurrencyCode
+	err := enum.NewDecoder(strings.NewReader(`"USD"`)).Decode(&c)
+
+	asrt.Equal("USD is not a valid enum", err.Error())
+}
@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type Color string
+
+const (
+	Red   Color = "RED"
+	Green Color = "GREEN"
+	Blue  Color = "BLUE"
+)
+
+var Colors = enum.Define(Red, Green, Blue)
+
+func (c *Color) UnmarshalJSON(b []byte) error {
+	return Colors.Unmarshal(b, c)
+}
+
+func TestDefine(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Equal([]Color{Red, Green, Blue}, Colors.Values())
+	asrt.Equal("Color", Colors.Descriptor().Name())
+	asrt.True(Colors.Has(Green))
+	asrt.False(Colors.Has("PURPLE"))
+}
+
+func TestDefineParse(t *testing.T) {
+	asrt := assert.New(t)
+
+	c, err := Colors.Parse("BLUE")
+	asrt.Nil(err)
+	asrt.Equal(Blue, c)
+
+	_, err = Colors.Parse("PURPLE")
+	asrt.Equal(&enum.InvalidEnumError{Type: "Color", Value: "PURPLE"}, err)
+
+	asrt.PanicsWithValue("PURPLE is not a valid enum", func() {
+		Colors.MustParse("PURPLE")
+	})
+}
+
+func TestDefineUnmarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	var v struct {
+		Color Color `json:"color"`
+	}
+
+	asrt.Nil(json.Unmarshal([]byte(`{"color":"RED"}`), &v))
+	asrt.Equal(Red, v.Color)
+	asrt.Error(json.Unmarshal([]byte(`{"color":"PURPLE"}`), &v))
+}
+
+func TestDefineNew(t *testing.T) {
+	asrt := assert.New(t)
+
+	e, err := Colors.New(Red)
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("RED"), e.Get())
+	asrt.Equal([]enum.Const{"RED", "GREEN", "BLUE"}, e.GetAll())
+	asrt.Nil(e.Set("GREEN"))
+	asrt.Nil(enum.Validate(e))
+
+	out, err := json.Marshal(e)
+	asrt.Nil(err)
+	asrt.Equal(`"GREEN"`, string(out))
+
+	_, err = Colors.New("PURPLE")
+	asrt.Error(err)
+}
+
+func TestDefineDuplicate(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.PanicsWithValue("RED is declared more than once", func() {
+		enum.Define(Red, Red)
+	})
+	asrt.PanicsWithValue("enum values cannot be empty", func() {
+		enum.Define[Color]("")
+	})
+}
@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"log"
+	"testing"
+)
+
+type LegacyCurrency struct {
+	enum.Enum
+	EUR enum.Const
+	DEM enum.Const `deprecated:"use EUR"`
+	FRF enum.Const `deprecated:""`
+}
+
+func TestDeprecatedExcludedFromGetAll(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(LegacyCurrency)).(*LegacyCurrency)
+
+	asrt.Equal([]enum.Const{"EUR"}, c.GetAll())
+	asrt.Equal([]enum.Const{"DEM", "FRF"}, c.Deprecated())
+}
+
+func TestDeprecatedStillValid(t *testing.T) {
+	asrt := assert.New(t)
+
+	c, err := enum.Construct(new(LegacyCurrency), "DEM")
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("DEM"), c.Get())
+
+	var v LegacyCurrency
+	asrt.Nil(v.UnmarshalJSON([]byte(`"FRF"`)))
+	asrt.Nil(enum.Validate(&v))
+}
+
+func TestDeprecatedWarning(t *testing.T) {
+	asrt := assert.New(t)
+
+	var buf bytes.Buffer
+	enum.SetLogger(log.New(&buf, "", 0))
+	defer enum.SetLogger(nil)
+
+	c := enum.New(new(LegacyCurrency)).(*LegacyCurrency)
+	c.MustSet(c.EUR)
+	asrt.Empty(buf.String())
+
+	c.MustSet(c.DEM)
+	asrt.Equal("DEM is deprecated: use EUR\n", buf.String())
+}
+
+func TestDescribeDeprecated(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, _ := enum.Describe(new(LegacyCurrency))
+
+	c, _ := d.Lookup("DEM")
+	asrt.Equal("use EUR", c.Deprecated)
+	c, _ = d.Lookup("FRF")
+	asrt.Equal("deprecated", c.Deprecated)
+}
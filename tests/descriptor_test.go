@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, _ := enum.Describe(new(CurrencyCode))
+
+	asrt.Equal("CurrencyCode", d.Name())
+	asrt.Equal([]enum.ConstDescriptor{
+		{Value: "ASd", Name: "USD", Ordinal: 0},
+		{Value: "DIA", Name: "DIA", Ordinal: 1},
+	}, d.Consts())
+	again, err := enum.Describe(new(CurrencyCode))
+	asrt.Nil(err)
+	asrt.Same(d, again)
+}
+
+func TestDescriptorLookup(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, _ := enum.Describe(new(CurrencyCode))
+
+	c, ok := d.Lookup("DIA")
+	asrt.True(ok)
+	asrt.Equal(1, c.Ordinal)
+
+	_, ok = d.Lookup("USD")
+	asrt.False(ok)
+}
+
+func TestDescriptorValues(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, _ := enum.Describe(new(CurrencyCode))
+	asrt.Equal([]string{"ASd", "DIA"}, d.Values())
+
+	d, _ = enum.Describe(new(TaggedCurrency))
+	asrt.Equal(enum.Strings(enum.New(new(TaggedCurrency)).GetAll()), d.Values())
+	asrt.NotContains(d.Values(), "CUSTOM")
+}
+
+func TestDescriptorFingerprint(t *testing.T) {
+	asrt := assert.New(t)
+
+	type OtherCurrencyCode struct {
+		enum.Enum
+		USD enum.Const `enum:"ASd"`
+	}
+
+	d, _ := enum.Describe(new(CurrencyCode))
+	other, _ := enum.Describe(new(OtherCurrencyCode))
+
+	asrt.Len(d.Fingerprint(), 16)
+	asrt.NotEqual(d.Fingerprint(), other.Fingerprint())
+}
+
+func TestDescriptorMarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, _ := enum.Describe(new(CurrencyCode))
+	out, err := json.Marshal(d)
+
+	asrt.Nil(err)
+	asrt.JSONEq(`{
+		"name": "CurrencyCode",
+		"fingerprint": "`+d.Fingerprint()+`",
+		"values": [
+			{"value": "ASd", "name": "USD", "ordinal": 0},
+			{"value": "DIA", "name": "DIA", "ordinal": 1}
+		]
+	}`, string(out))
+}
+
+type Month struct {
+	enum.Enum
+	January   enum.Const
+	February  enum.Const
+	March     enum.Const
+	April     enum.Const
+	May       enum.Const
+	June      enum.Const
+	July      enum.Const
+	August    enum.Const
+	September enum.Const
+	October   enum.Const
+	November  enum.Const
+	December  enum.Const
+}
+
+func TestDescriptorLookupLarge(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, _ := enum.Describe(new(Month))
+
+	c, ok := d.Lookup("December")
+	asrt.True(ok)
+	asrt.Equal(11, c.Ordinal)
+
+	_, ok = d.Lookup("Smarch")
+	asrt.False(ok)
+}
+
+func BenchmarkSetSmall(b *testing.B) {
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	for i := 0; i < b.N; i++ {
+		_ = c.Set(c.DIA)
+	}
+}
+
+func BenchmarkSetSmallInvalid(b *testing.B) {
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	for i := 0; i < b.N; i++ {
+		_ = c.Set("garbage")
+	}
+}
+
+func BenchmarkSetLarge(b *testing.B) {
+	m := enum.New(new(Month)).(*Month)
+	for i := 0; i < b.N; i++ {
+		_ = m.Set(m.December)
+	}
+}
+
+func BenchmarkValidateLarge(b *testing.B) {
+	m := enum.MustConstruct(new(Month), "November").(*Month)
+	for i := 0; i < b.N; i++ {
+		_ = enum.Validate(m)
+	}
+}
+
+func TestDescriptorShared(t *testing.T) {
+	asrt := assert.New(t)
+
+	a := enum.New(new(Month)).(*Month)
+	var b Month
+	asrt.Nil(b.UnmarshalJSON([]byte(`"May"`)))
+	asrt.Nil(enum.Validate(&b))
+
+	da, err := enum.Describe(a)
+	asrt.Nil(err)
+	db, err := enum.Describe(&b)
+	asrt.Nil(err)
+	asrt.Same(da, db)
+
+	all := a.GetAll()
+	all[0] = "garbage"
+	asrt.Equal(enum.Const("January"), b.GetAll()[0])
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type PlanV1 struct {
+	enum.Enum
+	Free  enum.Const `enum:"FREE"`
+	Basic enum.Const `enum:"BASIC"`
+	Pro   enum.Const `enum:"PRO"`
+}
+
+type PlanV2 struct {
+	enum.Enum
+	Free       enum.Const `enum:"FREE"`
+	Pro        enum.Const `enum:"PROFESSIONAL"`
+	Enterprise enum.Const `enum:"ENTERPRISE"`
+}
+
+func TestDiff(t *testing.T) {
+	asrt := assert.New(t)
+
+	changes := enum.Diff(new(PlanV1), new(PlanV2))
+	asrt.Equal([]enum.Const{"ENTERPRISE"}, changes.Added)
+	asrt.Equal([]enum.Const{"BASIC"}, changes.Removed)
+	asrt.Equal([]enum.Rename{{Name: "Pro", From: "PRO", To: "PROFESSIONAL"}}, changes.Renamed)
+	asrt.True(changes.Breaking())
+	asrt.False(changes.Empty())
+	asrt.Equal("+ENTERPRISE, -BASIC, Pro: PRO -> PROFESSIONAL", changes.String())
+
+	b, err := json.Marshal(changes)
+	asrt.Nil(err)
+	asrt.JSONEq(`{"added":["ENTERPRISE"],"removed":["BASIC"],"renamed":[{"name":"Pro","from":"PRO","to":"PROFESSIONAL"}]}`, string(b))
+}
+
+func TestDiffAdditive(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.True(enum.Diff(new(PlanV1), new(PlanV1)).Empty())
+
+	old, _ := enum.FromValues("Plan", []string{"FREE"})
+	changes := enum.Diff(old, new(PlanV1))
+	asrt.Equal([]enum.Const{"BASIC", "PRO"}, changes.Added)
+	asrt.False(changes.Breaking())
+}
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type DisplayCurrency struct {
+	enum.Enum
+	USD enum.Const `display:"US Dollar"`
+	EUR enum.Const `display:"Euro"`
+	CAD enum.Const
+	DEM enum.Const `display:"Deutsche Mark" retired:""`
+}
+
+func TestDisplayName(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.MustConstruct(new(DisplayCurrency), "USD").(*DisplayCurrency)
+	asrt.Equal("US Dollar", c.DisplayName())
+
+	c.MustSet(c.CAD)
+	asrt.Equal("CAD", c.DisplayName())
+
+	out, err := json.Marshal(c)
+	asrt.Nil(err)
+	asrt.Equal(`"CAD"`, string(out))
+}
+
+func TestDisplayNames(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(DisplayCurrency)).(*DisplayCurrency)
+
+	asrt.Equal(map[enum.Const]string{
+		"USD": "US Dollar",
+		"EUR": "Euro",
+		"CAD": "CAD",
+	}, c.DisplayNames())
+}
+
+func TestDescribeDisplay(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, _ := enum.Describe(new(DisplayCurrency))
+	c, _ := d.Lookup("EUR")
+
+	asrt.Equal("Euro", c.Display)
+}
+
+type Metal struct {
+	enum.Enum
+	XAU enum.Const `display:"Gold" desc:"One troy ounce of gold"`
+	XAG enum.Const `enum:"XAG,display=Silver,desc=One troy ounce of silver"`
+	XPT enum.Const
+}
+
+func TestDescription(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := enum.New(new(Metal)).(*Metal)
+	asrt.Equal("One troy ounce of gold", m.Description(m.XAU))
+	asrt.Equal("One troy ounce of silver", m.Description(m.XAG))
+	asrt.Equal("", m.Description(m.XPT))
+	asrt.Equal("", m.Description("XPD"))
+
+	d, _ := enum.Describe(m)
+	c, _ := d.Lookup("XAU")
+	asrt.Equal("One troy ounce of gold", c.Description)
+}
@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestFromValues(t *testing.T) {
+	asrt := assert.New(t)
+
+	e, err := enum.FromValues("Region", []string{"us-east-1", "eu-west-1"})
+	asrt.Nil(err)
+
+	asrt.Equal(enum.Const(""), e.Get())
+	asrt.Equal([]enum.Const{"us-east-1", "eu-west-1"}, e.GetAll())
+	asrt.Nil(e.Set("eu-west-1"))
+	asrt.EqualError(e.Set("ap-south-1"), "ap-south-1 is not a valid enum")
+
+	d, _ := enum.Describe(e)
+	asrt.Equal("Region", d.Name())
+
+	b, err := json.Marshal(e)
+	asrt.Nil(err)
+	asrt.Equal(`"eu-west-1"`, string(b))
+	asrt.Nil(json.Unmarshal([]byte(`"us-east-1"`), e))
+	asrt.Nil(enum.Validate(e))
+}
+
+func TestFromValuesInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := enum.FromValues("Region", []string{"us-east-1", "us-east-1"})
+	asrt.EqualError(err, "us-east-1 is declared more than once")
+
+	_, err = enum.FromValues("Region", []string{""})
+	asrt.EqualError(err, "enum values cannot be empty")
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumdynamo"
+	"testing"
+)
+
+type DynamoTender struct {
+	enum.Enum
+	Cash   enum.Const `enum:"CASH"`
+	Cheque enum.Const `enum:"CHEQUE" retired:""`
+}
+
+func (d DynamoTender) MarshalDynamoDBAttributeValue() (types.AttributeValue, error) {
+	return enumdynamo.Marshal(&d)
+}
+
+func (d *DynamoTender) UnmarshalDynamoDBAttributeValue(av types.AttributeValue) error {
+	return enumdynamo.Unmarshal(d, av)
+}
+
+type DynamoPayment struct {
+	ID     string       `dynamodbav:"id"`
+	Tender DynamoTender `dynamodbav:"tender"`
+}
+
+func TestDynamoRoundTrip(t *testing.T) {
+	asrt := assert.New(t)
+
+	p := DynamoPayment{ID: "1", Tender: *enum.MustConstruct(new(DynamoTender), "CASH").(*DynamoTender)}
+	item, err := attributevalue.MarshalMap(p)
+	asrt.Nil(err)
+	asrt.Equal(&types.AttributeValueMemberS{Value: "CASH"}, item["tender"])
+
+	var out DynamoPayment
+	asrt.Nil(attributevalue.UnmarshalMap(item, &out))
+	asrt.Equal(enum.Const("CASH"), out.Tender.Get())
+	asrt.Equal(enum.Const("CASH"), out.Tender.Cash)
+}
+
+func TestDynamoUnmarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	var out DynamoPayment
+	err := attributevalue.UnmarshalMap(map[string]types.AttributeValue{
+		"tender": &types.AttributeValueMemberS{Value: "CHEQUE"},
+	}, &out)
+	asrt.Nil(err)
+	asrt.True(out.Tender.IsRetired())
+
+	err = attributevalue.UnmarshalMap(map[string]types.AttributeValue{
+		"tender": &types.AttributeValueMemberS{Value: "IOU"},
+	}, &out)
+	asrt.ErrorContains(err, "IOU is not a valid enum")
+
+	err = attributevalue.UnmarshalMap(map[string]types.AttributeValue{
+		"tender": &types.AttributeValueMemberN{Value: "1"},
+	}, &out)
+	asrt.ErrorContains(err, "expected a string")
+}
+
+func TestDynamoMarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	item, err := attributevalue.MarshalMap(DynamoPayment{ID: "1"})
+	asrt.Nil(err)
+	asrt.Equal(&types.AttributeValueMemberNULL{Value: true}, item["tender"])
+
+	var retired DynamoTender
+	asrt.Nil(retired.UnmarshalJSON([]byte(`"CHEQUE"`)))
+	_, err = attributevalue.MarshalMap(DynamoPayment{ID: "1", Tender: retired})
+	asrt.ErrorContains(err, "CHEQUE has been retired")
+}
@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumecho"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type echoPayment struct {
+	CurrencyCode CurrencyCode `json:"currency_code" query:"currency" param:"currency"`
+}
+
+func TestEchoBind(t *testing.T) {
+	asrt := assert.New(t)
+
+	e := echo.New()
+	var got echoPayment
+	handler := func(c echo.Context) error {
+		got = echoPayment{}
+		if err := enumecho.Bind(c, &got); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusNoContent)
+	}
+	e.GET("/payments", handler)
+	e.GET("/payments/:currency", handler)
+	e.POST("/payments", handler)
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/payments/DIA", nil))
+	asrt.Equal(http.StatusNoContent, w.Code)
+	asrt.Equal(enum.Const("DIA"), got.CurrencyCode.Get())
+
+	w = httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/payments?currency=USD", nil))
+	asrt.Equal(http.StatusBadRequest, w.Code)
+	asrt.JSONEq(`{"errors":[{"field":"currency_code","message":"USD is not a valid enum"}]}`, w.Body.String())
+
+	req := httptest.NewRequest(http.MethodPost, "/payments", strings.NewReader(`{"currency_code":"USD"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+	asrt.Equal(http.StatusBadRequest, w.Code)
+}
+
+func TestEchoValidator(t *testing.T) {
+	asrt := assert.New(t)
+
+	e := echo.New()
+	e.Validator = enumecho.NewValidator(nil)
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+	var p echoPayment
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"ASd"}`), &p))
+	asrt.Nil(c.Validate(&p))
+
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"USD"}`), &p))
+	err := c.Validate(&p)
+	he, ok := err.(*echo.HTTPError)
+	asrt.True(ok)
+	asrt.Equal(http.StatusBadRequest, he.Code)
+	asrt.Equal(enumecho.ErrorResponse{Errors: []enumecho.FieldError{{Field: "currency_code", Message: "USD is not a valid enum"}}}, he.Message)
+}
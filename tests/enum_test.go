@@ -167,6 +167,51 @@ func TestGetAll(t *testing.T) {
 	asrt.Equal([]enum.Const{"ASd", "DIA"}, c.GetAll())
 }
 
+func TestCountAndHas(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	asrt.Equal(2, c.Count())
+	asrt.True(c.Has("DIA"))
+	asrt.False(c.Has("USD"))
+
+	l := enum.New(new(LegacyCurrency)).(*LegacyCurrency)
+	asrt.Equal(1, l.Count())
+	asrt.False(l.Has(l.DEM))
+
+	var u CurrencyCode
+	asrt.Equal(0, u.Count())
+	asrt.False(u.Has("DIA"))
+}
+
+func TestValuesAndNames(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	asrt.Equal([]string{"ASd", "DIA"}, c.Values())
+	asrt.Equal([]string{"USD", "DIA"}, c.Names())
+
+	var u CurrencyCode
+	asrt.Empty(u.Values())
+	asrt.Empty(u.Names())
+}
+
+func TestByName(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	v, ok := c.ByName("USD")
+	asrt.True(ok)
+	asrt.Equal(c.USD, v)
+
+	_, ok = c.ByName("ASd")
+	asrt.False(ok)
+
+	tender := enum.New(new(Tender)).(*Tender)
+	_, ok = tender.ByName("Cheque")
+	asrt.False(ok)
+}
+
 func TestNewWithValue(t *testing.T) {
 	asrt := assert.New(t)
 
@@ -176,3 +221,48 @@ func TestNewWithValue(t *testing.T) {
 	asrt.Equal(enum.Const("ASd"), e.USD)
 	asrt.Equal(enum.Const("DIA"), e.DIA)
 }
+
+type duplicateValues struct {
+	enum.Enum
+	USD    enum.Const
+	Dollar enum.Const `enum:"USD"`
+}
+
+type duplicateComposed struct {
+	enum.Enum
+	CurrencyCode
+	Dia enum.Const `enum:"DIA"`
+}
+
+func TestConstructDuplicateValues(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := enum.Construct(new(duplicateValues), "USD")
+	asrt.EqualError(err, "USD is declared by both USD and Dollar")
+
+	asrt.PanicsWithValue("USD is declared by both USD and Dollar", func() {
+		enum.New(new(duplicateValues))
+	})
+
+	_, err = enum.Construct(new(duplicateComposed), "DIA")
+	asrt.EqualError(err, "DIA is declared by both CurrencyCode.DIA and Dia")
+}
+
+type helperFields struct {
+	enum.Enum
+	USD     enum.Const
+	EUR     enum.Const
+	Default enum.Const `enum:"-"`
+	usd     enum.Const
+}
+
+func TestConstructSkipsFields(t *testing.T) {
+	asrt := assert.New(t)
+
+	h := enum.MustConstruct(new(helperFields), "USD").(*helperFields)
+
+	asrt.Equal([]enum.Const{"USD", "EUR"}, h.GetAll())
+	asrt.Equal(enum.Const(""), h.Default)
+	asrt.Equal(enum.Const(""), h.usd)
+	asrt.EqualError(h.Set("Default"), "Default is not a valid enum")
+}
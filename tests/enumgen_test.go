@@ -0,0 +1,447 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	_ "github.com/glebarez/go-sqlite"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumgen"
+	"testing"
+)
+
+const openAPISpec = `
+openapi: 3.0.3
+paths:
+  /orders:
+    get:
+      operationId: listOrders
+      parameters:
+        - name: sort_order
+          in: query
+          schema:
+            type: string
+            enum: [asc, desc]
+    post:
+      operationId: createOrder
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                priority:
+                  type: string
+                  enum: [low, high]
+components:
+  schemas:
+    Order:
+      type: object
+      properties:
+        status:
+          type: string
+          description: Where the order is up to
+          enum: [pending, in_progress, SHIPPED]
+          x-enum-descriptions: [Not yet picked, Being packed]
+        quantity:
+          type: integer
+          enum: [1, 2]
+    Currency:
+      type: [string, "null"]
+      enum: [USD, EUR, null]
+      x-enum-varnames: [Dollar, Euro]
+`
+
+func TestFromOpenAPI(t *testing.T) {
+	asrt := assert.New(t)
+
+	enums, err := enumgen.FromOpenAPI([]byte(openAPISpec))
+	asrt.Nil(err)
+	asrt.Equal([]enumgen.Enum{
+		{Name: "Currency", Values: []enumgen.Value{{Value: "USD", Field: "Dollar"}, {Value: "EUR", Field: "Euro"}}},
+		{Name: "OrderStatus", Doc: "Where the order is up to", Values: []enumgen.Value{
+			{Value: "pending", Doc: "Not yet picked"},
+			{Value: "in_progress", Doc: "Being packed"},
+			{Value: "SHIPPED"},
+		}},
+		{Name: "SortOrder", Values: []enumgen.Value{{Value: "asc"}, {Value: "desc"}}},
+		{Name: "CreateOrderPriority", Values: []enumgen.Value{{Value: "low"}, {Value: "high"}}},
+	}, enums)
+}
+
+func TestGenerate(t *testing.T) {
+	asrt := assert.New(t)
+
+	enums, err := enumgen.FromOpenAPI([]byte(openAPISpec))
+	asrt.Nil(err)
+
+	var b bytes.Buffer
+	asrt.Nil(enumgen.Generate(&b, "api", enums[1:2]))
+	asrt.Equal("// Code generated by goenum. DO NOT EDIT.\n\n"+
+		"package api\n\n"+
+		"import \"github.com/eddieowens/go-enum\"\n\n"+
+		"// Where the order is up to\n"+
+		"type OrderStatus struct {\n"+
+		"\tenum.Enum\n"+
+		"\t// Not yet picked\n"+
+		"\tPending enum.Const `enum:\"pending\"`\n"+
+		"\t// Being packed\n"+
+		"\tInProgress enum.Const `enum:\"in_progress\"`\n"+
+		"\tShipped    enum.Const `enum:\"SHIPPED\"`\n"+
+		"}\n", b.String())
+
+	b.Reset()
+	asrt.Nil(enumgen.Generate(&b, "api", []enumgen.Enum{{Name: "Status", Values: []enumgen.Value{
+		{Value: "shipped", Display: "Shipped"},
+		{Value: "LOST", Deprecated: "rarely happens", Aliases: []string{"MISSING", "GONE"}},
+		{Value: "STUCK", Deprecated: "deprecated"},
+	}}}))
+	asrt.Equal("// Code generated by goenum. DO NOT EDIT.\n\n"+
+		"package api\n\n"+
+		"import \"github.com/eddieowens/go-enum\"\n\n"+
+		"type Status struct {\n"+
+		"\tenum.Enum\n"+
+		"\tShipped enum.Const `enum:\"shipped\" display:\"Shipped\"`\n"+
+		"\tLost    enum.Const `enum:\"LOST,deprecated=rarely happens,alias=MISSING,alias=GONE\"`\n"+
+		"\tStuck   enum.Const `enum:\"STUCK,deprecated\"`\n"+
+		"}\n", b.String())
+
+	err = enumgen.Generate(&b, "api", []enumgen.Enum{{Name: "Bad", Values: []enumgen.Value{{Value: "a,b"}}}})
+	asrt.EqualError(err, `"a,b" of Bad can't be declared in an enum tag`)
+
+	err = enumgen.Generate(&b, "api", []enumgen.Enum{{Name: "Bad", Values: []enumgen.Value{{Value: "a", Aliases: []string{"b,c"}}}}})
+	asrt.EqualError(err, `"a" of Bad can't be declared in an enum tag`)
+}
+
+const jsonSchema = `{
+  "title": "payment method",
+  "type": "string",
+  "enum": ["card", "cash"],
+  "$defs": {
+    "Currency": {
+      "description": "ISO 4217 currencies and metals",
+      "oneOf": [
+        {"const": "USD", "title": "US Dollar"},
+        {"const": "XAU", "title": "Gold", "description": "One troy ounce of gold, \"fine\""},
+        {"type": "null"}
+      ]
+    },
+    "Address": {
+      "type": "object",
+      "properties": {
+        "kind": {"anyOf": [{"const": "home"}, {"const": "work"}]},
+        "country": {"oneOf": [{"$ref": "#/$defs/Country"}]}
+      }
+    }
+  }
+}`
+
+func TestFromJSONSchema(t *testing.T) {
+	asrt := assert.New(t)
+
+	enums, err := enumgen.FromJSONSchema([]byte(jsonSchema))
+	asrt.Nil(err)
+	asrt.Equal([]enumgen.Enum{
+		{Name: "PaymentMethod", Values: []enumgen.Value{{Value: "card"}, {Value: "cash"}}},
+		{Name: "AddressKind", Values: []enumgen.Value{{Value: "home"}, {Value: "work"}}},
+		{Name: "Currency", Doc: "ISO 4217 currencies and metals", Values: []enumgen.Value{
+			{Value: "USD", Display: "US Dollar"},
+			{Value: "XAU", Display: "Gold", Description: `One troy ounce of gold, "fine"`},
+		}},
+	}, enums)
+
+	var b bytes.Buffer
+	asrt.Nil(enumgen.Generate(&b, "pay", enums[2:]))
+	asrt.Contains(b.String(), "\tXau enum.Const `enum:\"XAU\" display:\"Gold\" desc:\"One troy ounce of gold, \\\"fine\\\"\"`\n")
+
+	_, err = enumgen.FromJSONSchema([]byte(`{`))
+	asrt.Error(err)
+}
+
+func TestLoad(t *testing.T) {
+	asrt := assert.New(t)
+
+	enums, err := enumgen.Load("./testdata/exports")
+	asrt.Nil(err)
+	currency := []enumgen.Value{
+		{Value: "USD", Field: "USD", Display: "US Dollar"},
+		{Value: "EUR", Field: "EUR", Description: "Euro, used across the eurozone", Code: 1},
+		{Value: "DEM", Field: "DEM", Deprecated: "use EUR", Code: 2},
+	}
+	retired := []enumgen.Value{{Value: "FRF", Field: "FRF", Code: 3}}
+	asrt.Equal([]enumgen.Enum{
+		{Name: "Currency", Package: "exports", Doc: "The currencies payments can be made in", Values: currency, Retired: retired},
+		{Name: "PaymentCurrency", Package: "exports", Values: append(append([]enumgen.Value{}, currency...), enumgen.Value{Value: "XAU", Field: "XAU", Code: 4}), Retired: retired},
+		{Name: "Priority", Package: "exports", Values: []enumgen.Value{
+			{Value: "LOW", Field: "Low", Code: 1},
+			{Value: "HIGH", Field: "High", Code: 3},
+		}, Retired: []enumgen.Value{{Value: "MEDIUM", Field: "Medium", Code: 2}}},
+		{Name: "Status", Package: "exports", Values: []enumgen.Value{
+			{Value: "in_transit", Field: "InTransit"},
+			{Value: "shipped", Field: "Shipped", Code: 1},
+			{Value: "LOST", Field: "Lost", Deprecated: "rarely happens", Code: 2, Aliases: []string{"MISSING", "GONE"}},
+		}},
+		{Name: "Tier", Package: "exports", Values: []enumgen.Value{
+			{Value: "Gold", Field: "Gold"},
+			{Value: "Silver", Field: "Silver", Code: 1},
+		}},
+	}, enums)
+
+	_, err = enumgen.Load("./testdata/missing")
+	asrt.Error(err)
+}
+
+func TestFromDescriptor(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, err := enum.Describe(new(DisplayCurrency))
+	asrt.Nil(err)
+	asrt.Equal(enumgen.Enum{Name: "DisplayCurrency", Values: []enumgen.Value{
+		{Value: "USD", Field: "USD", Display: "US Dollar"},
+		{Value: "EUR", Field: "EUR", Display: "Euro", Code: 1},
+		{Value: "CAD", Field: "CAD", Code: 2},
+	}, Retired: []enumgen.Value{
+		{Value: "DEM", Field: "DEM", Display: "Deutsche Mark", Code: 3},
+	}}, enumgen.FromDescriptor(d))
+}
+
+func TestTypeScript(t *testing.T) {
+	asrt := assert.New(t)
+
+	enums := []enumgen.Enum{{Name: "Currency", Doc: "The currencies payments can be made in", Values: []enumgen.Value{
+		{Value: "USD", Field: "USD"},
+		{Value: "EUR", Field: "EUR", Description: "Euro, used across the eurozone"},
+		{Value: "DEM", Field: "DEM", Deprecated: "use EUR"},
+	}}}
+
+	var b bytes.Buffer
+	asrt.Nil(enumgen.TypeScript(&b, enums))
+	asrt.Equal(`// Code generated by goenum. DO NOT EDIT.
+
+/** The currencies payments can be made in */
+export const Currency = {
+  USD: "USD",
+  /** Euro, used across the eurozone */
+  EUR: "EUR",
+  /** @deprecated use EUR */
+  DEM: "DEM",
+} as const;
+
+/** The currencies payments can be made in */
+export type Currency = (typeof Currency)[keyof typeof Currency];
+`, b.String())
+
+	b.Reset()
+	asrt.Nil(enumgen.TypeScriptDeclarations(&b, enums))
+	asrt.Equal(`// Code generated by goenum. DO NOT EDIT.
+
+/** The currencies payments can be made in */
+export type Currency = "USD" | "EUR" | "DEM";
+
+/** The currencies payments can be made in */
+export declare const Currency: {
+  readonly USD: "USD";
+  /** Euro, used across the eurozone */
+  readonly EUR: "EUR";
+  /** @deprecated use EUR */
+  readonly DEM: "DEM";
+};
+`, b.String())
+}
+
+func TestSQL(t *testing.T) {
+	asrt := assert.New(t)
+
+	enums := []enumgen.Enum{
+		{Name: "OrderStatus", Values: []enumgen.Value{{Value: "new"}, {Value: "pending"}, {Value: "packed"}, {Value: "on hold"}, {Value: "shipped"}}},
+		{Name: "Tier", Values: []enumgen.Value{{Value: "FREE"}, {Value: "O'NEIL"}}},
+		{Name: "Unchanged", Values: []enumgen.Value{{Value: "A"}}},
+	}
+	existing, err := enumgen.FromPostgres(context.Background(), pgCatalogDB(t))
+	asrt.Nil(err)
+	existing = append(existing, enumgen.Enum{Name: "Unchanged", Values: []enumgen.Value{{Value: "A"}}})
+
+	var b bytes.Buffer
+	asrt.Nil(enumgen.SQL(&b, "postgres", enums, existing))
+	asrt.Equal(`-- Code generated by goenum. DO NOT EDIT.
+
+ALTER TYPE "order_status" ADD VALUE IF NOT EXISTS 'new' BEFORE 'pending';
+ALTER TYPE "order_status" ADD VALUE IF NOT EXISTS 'on hold' AFTER 'packed';
+
+CREATE TYPE "tier" AS ENUM ('FREE', 'O''NEIL');
+`, b.String())
+
+	existing[1].Values = append(existing[1].Values, enumgen.Value{Value: "lost"})
+	b.Reset()
+	asrt.Nil(enumgen.SQL(&b, "postgres", enums[:1], existing))
+	asrt.Contains(b.String(), `-- "order_status" still holds 'lost', which OrderStatus no longer declares`)
+
+	err = enumgen.SQL(&b, "mysql", enums, nil)
+	asrt.EqualError(err, `"mysql" is not a supported dialect, expected postgres`)
+}
+
+func TestProto(t *testing.T) {
+	asrt := assert.New(t)
+
+	enums, err := enumgen.Load("./testdata/exports")
+	asrt.Nil(err)
+
+	var b bytes.Buffer
+	asrt.Nil(enumgen.Proto(&b, "shop.v1", []enumgen.Enum{enums[0], enums[2]}))
+	asrt.Equal(`// Code generated by goenum. DO NOT EDIT.
+
+syntax = "proto3";
+
+package shop.v1;
+
+// The currencies payments can be made in
+enum Currency {
+  reserved 3;
+  reserved "CURRENCY_FRF";
+  CURRENCY_USD = 0;
+  // Euro, used across the eurozone
+  CURRENCY_EUR = 1;
+  CURRENCY_DEM = 2 [deprecated = true];
+}
+
+enum Priority {
+  reserved 2;
+  reserved "PRIORITY_MEDIUM";
+  PRIORITY_UNSPECIFIED = 0;
+  PRIORITY_LOW = 1;
+  PRIORITY_HIGH = 3;
+}
+`, b.String())
+
+	bad := enumgen.Enum{Name: "Bad", Values: []enumgen.Value{{Value: "A", Code: 1}}, Retired: []enumgen.Value{{Value: "B"}}}
+	err = enumgen.Proto(&b, "", []enumgen.Enum{bad})
+	asrt.EqualError(err, "Bad has no value with code 0 and its retired B holds it, proto3 enums must start at 0")
+}
+
+var docEnums = []enumgen.Enum{{Name: "Status", Doc: "Where an order is up to", Values: []enumgen.Value{
+	{Value: "PENDING", Description: "Waiting to be <packed>"},
+	{Value: "SHIPPED", Display: "Shipped | on its way"},
+	{Value: "LOST", Deprecated: "rarely happens", Aliases: []string{"MISSING", "GONE"}},
+}}}
+
+func TestMarkdown(t *testing.T) {
+	asrt := assert.New(t)
+
+	var b bytes.Buffer
+	asrt.Nil(enumgen.Markdown(&b, docEnums))
+	asrt.Equal("## Status\n\n"+
+		"Where an order is up to\n\n"+
+		"| Value | Description | Deprecated | Aliases |\n"+
+		"| --- | --- | --- | --- |\n"+
+		"| `PENDING` | Waiting to be <packed> |  |  |\n"+
+		"| `SHIPPED` | Shipped \\| on its way |  |  |\n"+
+		"| `LOST` |  | rarely happens | `MISSING`, `GONE` |\n", b.String())
+}
+
+func TestHTML(t *testing.T) {
+	asrt := assert.New(t)
+
+	var b bytes.Buffer
+	asrt.Nil(enumgen.HTML(&b, docEnums))
+	asrt.Contains(b.String(), `<section id="Status">`)
+	asrt.Contains(b.String(), "<p>Where an order is up to</p>")
+	asrt.Contains(b.String(), "<tr><td><code>PENDING</code></td><td>Waiting to be &lt;packed&gt;</td><td></td><td></td></tr>")
+	asrt.Contains(b.String(), "<tr><td><code>LOST</code></td><td></td><td>rarely happens</td><td><code>MISSING</code>, <code>GONE</code></td></tr>")
+	asrt.Equal("Waiting to be <packed>", docEnums[0].Values[0].Description)
+	asrt.Equal("", docEnums[0].Values[1].Description)
+}
+
+func TestStringer(t *testing.T) {
+	asrt := assert.New(t)
+
+	enums, err := enumgen.Load("./testdata/exports")
+	asrt.Nil(err)
+
+	var b bytes.Buffer
+	asrt.Nil(enumgen.Stringer(&b, "Level", enums[3]))
+	asrt.Equal(`// Code generated by goenum -stringer. DO NOT EDIT.
+
+package exports
+
+import "strconv"
+
+type Level int
+
+const (
+	InTransit Level = 0
+	Shipped   Level = 1
+	// Deprecated: rarely happens
+	Lost Level = 2
+)
+
+func (i Level) String() string {
+	switch i {
+	case InTransit:
+		return "in_transit"
+	case Shipped:
+		return "shipped"
+	case Lost:
+		return "LOST"
+	}
+	return "Level(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+`, b.String())
+}
+
+func TestGoName(t *testing.T) {
+	asrt := assert.New(t)
+
+	for in, out := range map[string]string{
+		"in_progress": "InProgress",
+		"IN-PROGRESS": "InProgress",
+		"inProgress":  "InProgress",
+		"USD":         "Usd",
+		"2fa":         "V2fa",
+		"":            "Empty",
+	} {
+		asrt.Equal(out, enumgen.GoName(in), in)
+	}
+}
+
+// A database faking the parts of the Postgres catalog FromPostgres reads.
+func pgCatalogDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	_, err = db.Exec(`
+CREATE TABLE pg_namespace (oid INTEGER, nspname TEXT);
+CREATE TABLE pg_type (oid INTEGER, typname TEXT, typnamespace INTEGER);
+CREATE TABLE pg_enum (enumtypid INTEGER, enumlabel TEXT, enumsortorder REAL);
+CREATE TABLE pg_description (objoid INTEGER, objsubid INTEGER, description TEXT);
+INSERT INTO pg_namespace VALUES (1, 'public'), (2, 'billing');
+INSERT INTO pg_type VALUES (10, 'order_status', 1), (11, 'currency', 2);
+INSERT INTO pg_enum VALUES (10, 'pending', 1), (10, 'shipped', 3), (10, 'packed', 2), (11, 'USD', 1);
+INSERT INTO pg_description VALUES (10, 0, 'Where the order is up to')`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestFromPostgres(t *testing.T) {
+	asrt := assert.New(t)
+
+	db := pgCatalogDB(t)
+	enums, err := enumgen.FromPostgres(context.Background(), db)
+	asrt.Nil(err)
+	asrt.Equal([]enumgen.Enum{
+		{Name: "BillingCurrency", Values: []enumgen.Value{{Value: "USD"}}},
+		{Name: "OrderStatus", Doc: "Where the order is up to", Values: []enumgen.Value{
+			{Value: "pending"}, {Value: "packed"}, {Value: "shipped"},
+		}},
+	}, enums)
+
+	enums, err = enumgen.FromPostgres(context.Background(), db, "public")
+	asrt.Nil(err)
+	asrt.Len(enums, 1)
+	asrt.Equal("OrderStatus", enums[0].Name)
+}
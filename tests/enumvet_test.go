@@ -0,0 +1,15 @@
+package tests
+
+import (
+	"go-enum/enumvet"
+	"golang.org/x/tools/go/analysis/analysistest"
+	"testing"
+)
+
+func TestExhaustive(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), enumvet.Exhaustive, "switches")
+}
+
+func TestDeclaration(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), enumvet.Declaration, "declarations")
+}
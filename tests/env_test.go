@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type envConfig struct {
+	Billing struct {
+		CurrencyCode CurrencyCode
+		Fallback     *CurrencyCode `env:"FALLBACK"`
+		Ignored      CurrencyCode  `env:"-"`
+	}
+	HTTPCurrency CurrencyCode
+}
+
+func TestParse(t *testing.T) {
+	asrt := assert.New(t)
+
+	c, err := enum.Parse(new(CurrencyCode), "DIA")
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("DIA"), c)
+
+	_, err = enum.Parse(new(CurrencyCode), "asd")
+	asrt.Equal("asd is not a valid enum, did you mean ASd?", err.Error())
+
+	c, err = enum.Parse(new(CurrencyCode), "asd", enum.CaseInsensitive())
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("ASd"), c)
+}
+
+func TestFromEnv(t *testing.T) {
+	asrt := assert.New(t)
+
+	t.Setenv("CURRENCY", "dia")
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+
+	asrt.Equal("CURRENCY: dia is not a valid enum, did you mean DIA?", enum.FromEnv(c, "CURRENCY").Error())
+	asrt.Nil(enum.FromEnv(c, "CURRENCY", enum.CaseInsensitive()))
+	asrt.Equal(c.DIA, c.Get())
+}
+
+func TestFromEnvUnset(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.MustConstruct(new(CurrencyCode), "ASd").(*CurrencyCode)
+
+	asrt.Nil(enum.FromEnv(c, "GO_ENUM_UNSET_VARIABLE"))
+	asrt.Equal(c.USD, c.Get())
+}
+
+func TestLoadEnv(t *testing.T) {
+	asrt := assert.New(t)
+
+	t.Setenv("APP_BILLING_CURRENCY_CODE", "DIA")
+	t.Setenv("APP_BILLING_FALLBACK", "ASd")
+	t.Setenv("APP_BILLING_IGNORED", "DIA")
+	t.Setenv("APP_HTTP_CURRENCY", "DIA")
+
+	var cfg envConfig
+	cfg.Billing.Fallback = new(CurrencyCode)
+
+	asrt.Nil(enum.LoadEnv(&cfg, "APP"))
+	asrt.Equal(enum.Const("DIA"), cfg.Billing.CurrencyCode.Get())
+	asrt.Equal(enum.Const("ASd"), cfg.Billing.Fallback.Get())
+	asrt.Equal(enum.Const(""), cfg.Billing.Ignored.Get())
+	asrt.Equal(enum.Const("DIA"), cfg.HTTPCurrency.Get())
+}
+
+func TestLoadEnvInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	t.Setenv("APP_BILLING_CURRENCY_CODE", "USD")
+
+	var cfg envConfig
+	err := enum.LoadEnv(&cfg, "APP")
+
+	asrt.Equal("APP_BILLING_CURRENCY_CODE: USD is not a valid enum", err.Error())
+}
@@ -0,0 +1,29 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum/enumenvconfig"
+	"testing"
+)
+
+type envconfigSpec struct {
+	Currency CurrencyCode   `envconfig:"CURRENCY"`
+	Tagged   TaggedCurrency `envconfig:"TAGGED"`
+	Fallback CurrencyCode   `envconfig:"FALLBACK" default:"DIA"`
+}
+
+func TestEnvconfigProcess(t *testing.T) {
+	asrt := assert.New(t)
+
+	t.Setenv("APP_CURRENCY", "ASd")
+
+	var spec envconfigSpec
+	asrt.Nil(enumenvconfig.Process("app", &spec))
+	asrt.Equal(spec.Currency.USD, spec.Currency.Get())
+	asrt.Equal(spec.Tagged.USD, spec.Tagged.Get())
+	asrt.Equal(spec.Fallback.DIA, spec.Fallback.Get())
+
+	t.Setenv("APP_TAGGED", "GBP")
+	err := enumenvconfig.Process("app", &envconfigSpec{})
+	asrt.EqualError(err, "Tagged: GBP is not a valid enum")
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"strings"
+	"testing"
+)
+
+func TestInvalidEnumError(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	err := c.Set("EUR")
+
+	asrt.Equal(&enum.InvalidEnumError{Type: "CurrencyCode", Value: "EUR"}, err)
+}
+
+func TestInvalidValuesInJoined(t *testing.T) {
+	asrt := assert.New(t)
+
+	first := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	second := enum.New(new(Tender)).(*Tender)
+
+	err := errors.Join(
+		fmt.Errorf("first: %w", first.Set("EUR")),
+		errors.New("unrelated"),
+		second.Set(second.Cheque),
+	)
+
+	asrt.Equal([]enum.InvalidEnumError{
+		{Type: "CurrencyCode", Value: "EUR"},
+		{Type: "Tender", Value: "CHEQUE", Retired: true},
+	}, enum.InvalidValuesIn(err))
+}
+
+func TestInvalidValuesInFieldPath(t *testing.T) {
+	asrt := assert.New(t)
+
+	var v decodeTest
+	err := enum.DecodeStrict(strings.NewReader(`{"currency_code":"ASd","nested":{"currency_code":"USD"}}`), &v)
+
+	asrt.Equal([]enum.InvalidEnumError{
+		{Type: "CurrencyCode", Value: "USD", Field: "nested.currency_code"},
+	}, enum.InvalidValuesIn(err))
+}
+
+func TestInvalidValuesInNone(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Nil(enum.InvalidValuesIn(nil))
+	asrt.Nil(enum.InvalidValuesIn(errors.New("unrelated")))
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	asrt := assert.New(t)
+
+	var v decodeTest
+	err := enum.DecodeStrict(strings.NewReader(`{"currency_code":"ASd","nested":{"currency_code":"USD"}}`), &v)
+	b, jerr := json.Marshal(enum.NewErrorResponse(err))
+	asrt.Nil(jerr)
+	asrt.Equal(`{"errors":[{"field":"nested.currency_code","message":"USD is not a valid enum"}]}`, string(b))
+
+	asrt.Equal(enum.ErrorResponse{Errors: []enum.ErrorDetail{{Message: "unrelated"}}}, enum.NewErrorResponse(errors.New("unrelated")))
+}
+
+func TestValidateAll(t *testing.T) {
+	asrt := assert.New(t)
+
+	var v decodeTest
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"EUR","history":["DIA","GBP"],"by_region":{"eu":"ASd"}}`), &v))
+
+	err := enum.ValidateAll(&v)
+	asrt.Equal("currency_code: EUR is not a valid enum; history[1]: GBP is not a valid enum", err.Error())
+
+	multi, ok := err.(*enum.MultiError)
+	asrt.True(ok)
+	asrt.Len(multi.Unwrap(), 2)
+	asrt.Equal(&enum.FieldError{
+		Field: "history[1]",
+		Err:   &enum.InvalidEnumError{Type: "CurrencyCode", Value: "GBP"},
+	}, multi.Errors[1])
+
+	asrt.Equal([]enum.InvalidEnumError{
+		{Type: "CurrencyCode", Value: "EUR", Field: "currency_code"},
+		{Type: "CurrencyCode", Value: "GBP", Field: "history[1]"},
+	}, enum.InvalidValuesIn(err))
+}
+
+func TestValidateAllMapOrder(t *testing.T) {
+	asrt := assert.New(t)
+
+	var v decodeTest
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"DIA","by_region":{"us":"USD","eu":"EUR","asia":"JPY","au":"ASd"}}`), &v))
+
+	for i := 0; i < 10; i++ {
+		asrt.EqualError(enum.ValidateAll(&v), "by_region[asia]: JPY is not a valid enum; by_region[eu]: EUR is not a valid enum; by_region[us]: USD is not a valid enum")
+	}
+}
+
+func TestValidateAllCycle(t *testing.T) {
+	asrt := assert.New(t)
+
+	var v decodeTest
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"EUR"}`), &v))
+	v.Nested = &v
+
+	asrt.EqualError(enum.ValidateAll(&v), "currency_code: EUR is not a valid enum")
+}
+
+func TestValidateAllValid(t *testing.T) {
+	asrt := assert.New(t)
+
+	var v decodeTest
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"ASd","history":["DIA"]}`), &v))
+	asrt.Nil(enum.ValidateAll(&v))
+}
+
+func TestValidateAllLenient(t *testing.T) {
+	asrt := assert.New(t)
+
+	tenders := make([]Tender, 2)
+	asrt.Nil(tenders[0].UnmarshalJSON([]byte(`"CASH"`)))
+	asrt.Nil(tenders[1].UnmarshalJSON([]byte(`"CHEQUE"`)))
+
+	asrt.EqualError(enum.ValidateAll(tenders), "[1]: CHEQUE has been retired")
+	asrt.Nil(enum.ValidateAll(tenders, enum.Lenient()))
+}
+
+func TestInvalidEnumErrorSuggestions(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := enum.New(new(Month)).(*Month)
+
+	err := m.Set("Decmber")
+	asrt.Equal(&enum.InvalidEnumError{Type: "Month", Value: "Decmber", Suggestions: []enum.Const{"December"}}, err)
+	asrt.EqualError(err, "Decmber is not a valid enum, did you mean December?")
+
+	_, err = enum.Parse(m, "june")
+	asrt.EqualError(err, "june is not a valid enum, did you mean June?")
+
+	asrt.EqualError(m.Set("Smarch"), "Smarch is not a valid enum, did you mean March?")
+	asrt.EqualError(m.Set("Blursday"), "Blursday is not a valid enum")
+}
+
+func TestInvalidEnumErrorSuggestionsSkipUnlisted(t *testing.T) {
+	asrt := assert.New(t)
+
+	l := enum.New(new(LegacyCurrency)).(*LegacyCurrency)
+	_, err := enum.Parse(l, "eur")
+	asrt.EqualError(err, "eur is not a valid enum, did you mean EUR?")
+
+	_, err = enum.Parse(l, "dem")
+	asrt.EqualError(err, "dem is not a valid enum")
+}
+
+func TestInvalidEnumErrorSeveralSuggestions(t *testing.T) {
+	asrt := assert.New(t)
+
+	type Sizes struct {
+		enum.Enum
+		Small  enum.Const `enum:"SMALL"`
+		Smalls enum.Const `enum:"SMALLS"`
+		Smell  enum.Const `enum:"SMELL"`
+	}
+
+	_, err := enum.Parse(new(Sizes), "small")
+	asrt.EqualError(err, "small is not a valid enum, did you mean SMALL, SMALLS or SMELL?")
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"sync"
+	"testing"
+)
+
+type StorageProvider struct {
+	enum.Enum `extensible:""`
+	Local     enum.Const `enum:"LOCAL"`
+	Memory    enum.Const `enum:"MEMORY"`
+}
+
+type PluginProvider struct {
+	enum.Enum `extensible:""`
+	Builtin   enum.Const `enum:"BUILTIN"`
+}
+
+func TestExtend(t *testing.T) {
+	asrt := assert.New(t)
+
+	p := enum.MustConstruct(new(StorageProvider), "LOCAL").(*StorageProvider)
+	d, _ := enum.Describe(p)
+	before := d.Fingerprint()
+
+	asrt.Nil(enum.Extend(new(StorageProvider), "S3"))
+
+	asrt.Nil(p.Set("S3"))
+	asrt.Equal(enum.Const("S3"), p.Get())
+	asrt.Equal([]enum.Const{"LOCAL", "MEMORY", "S3"}, p.GetAll())
+	asrt.NotEqual(before, d.Fingerprint())
+
+	c, ok := d.Lookup("S3")
+	asrt.True(ok)
+	asrt.Equal(2, c.Ordinal)
+}
+
+func TestExtendDuplicate(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.EqualError(enum.Extend(new(StorageProvider), "LOCAL"), "LOCAL is declared more than once")
+	asrt.Nil(enum.Extend(new(StorageProvider), "local"))
+	asrt.EqualError(enum.Extend(new(StorageProvider), "Memory", enum.CaseInsensitive()), "Memory is declared more than once")
+	asrt.EqualError(enum.Extend(new(StorageProvider), ""), "enum values cannot be empty")
+}
+
+func TestExtendNotExtensible(t *testing.T) {
+	asrt := assert.New(t)
+
+	err := enum.Extend(new(CurrencyCode), "JPY")
+	asrt.EqualError(err, "CurrencyCode is not extensible, tag its enum.Enum with extensible to allow Extend")
+}
+
+func TestExtendConcurrent(t *testing.T) {
+	asrt := assert.New(t)
+
+	p := enum.MustConstruct(new(PluginProvider), "BUILTIN").(*PluginProvider)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			asrt.Nil(enum.Extend(new(PluginProvider), enum.Const(fmt.Sprintf("PLUGIN_%d", i))))
+		}(i)
+		go func() {
+			defer wg.Done()
+			q := enum.MustConstruct(new(PluginProvider), "BUILTIN").(*PluginProvider)
+			asrt.Nil(q.Set(q.Builtin))
+			q.GetAll()
+		}()
+	}
+	wg.Wait()
+
+	asrt.Len(p.GetAll(), 51)
+	asrt.Nil(p.Set("PLUGIN_49"))
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumfake"
+	"testing"
+)
+
+type fakeRow struct {
+	CurrencyCode CurrencyCode
+	Statuses     []*OrderStatus
+	Label        string
+	Registered   string `fake:"{fake_test_currency}"`
+}
+
+func TestFakeFill(t *testing.T) {
+	asrt := assert.New(t)
+
+	v := fakeRow{Statuses: []*OrderStatus{new(OrderStatus), new(OrderStatus)}}
+
+	asrt.Nil(enum.FakeFill(&v))
+	asrt.Nil(enum.Validate(&v.CurrencyCode))
+	for _, s := range v.Statuses {
+		asrt.Contains(s.GetAll(), s.Get())
+	}
+}
+
+func TestFakeStruct(t *testing.T) {
+	asrt := assert.New(t)
+
+	enumfake.Register("fake_test_currency", new(CurrencyCode))
+
+	var v fakeRow
+	asrt.Nil(enumfake.Struct(gofakeit.New(1), &v))
+
+	asrt.Nil(enum.Validate(&v.CurrencyCode))
+	asrt.Equal(enum.Const("ASd"), v.CurrencyCode.USD)
+	asrt.NotEmpty(v.Label)
+	asrt.Contains([]string{"ASd", "DIA"}, v.Registered)
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"flag"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumcobra"
+	"testing"
+)
+
+func TestFlag(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := new(CurrencyCode)
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Var(enum.Flag(c), "currency", "the currency")
+
+	asrt.Nil(fs.Parse([]string{"--currency", "DIA"}))
+	asrt.Equal(c.DIA, c.Get())
+	asrt.Equal("CurrencyCode", fs.Lookup("currency").Value.Type())
+	asrt.Equal("DIA", fs.Lookup("currency").Value.String())
+}
+
+func TestFlagInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.Var(enum.Flag(new(CurrencyCode)), "currency", "the currency")
+
+	err := fs.Parse([]string{"--currency", "USD"})
+	asrt.Error(err)
+	asrt.Contains(err.Error(), "USD is not a valid enum")
+}
+
+func TestFlagStandardLibrary(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(enum.Flag(c), "currency", "the currency")
+
+	asrt.Nil(fs.Parse([]string{"-currency", "ASd"}))
+	asrt.Equal(c.USD, c.Get())
+}
+
+func TestCompletionFunc(t *testing.T) {
+	asrt := assert.New(t)
+
+	complete := enumcobra.CompletionFunc(new(LegacyCurrency))
+
+	all, directive := complete(&cobra.Command{}, nil, "")
+	asrt.Equal([]string{"EUR"}, all)
+	asrt.Equal(cobra.ShellCompDirectiveNoFileComp, directive)
+
+	none, _ := complete(&cobra.Command{}, nil, "X")
+	asrt.Empty(none)
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"github.com/go-playground/form/v4"
+	"github.com/gorilla/schema"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumform"
+	"go-enum/enumschema"
+	"net/url"
+	"testing"
+)
+
+type formPayment struct {
+	CurrencyCode CurrencyCode  `schema:"currency" form:"currency"`
+	Previous     *CurrencyCode `schema:"previous" form:"previous"`
+	Amount       int           `schema:"amount" form:"amount"`
+}
+
+func TestSchemaDecode(t *testing.T) {
+	asrt := assert.New(t)
+
+	d := schema.NewDecoder()
+
+	var p formPayment
+	asrt.Nil(enumschema.Decode(d, &p, url.Values{"currency": {"DIA"}, "previous": {"ASd"}, "amount": {"5"}}))
+	asrt.Equal(p.CurrencyCode.DIA, p.CurrencyCode.Get())
+	asrt.Equal(enum.Const("ASd"), p.Previous.Get())
+	asrt.Equal(5, p.Amount)
+
+	p = formPayment{}
+	err := enumschema.Decode(d, &p, url.Values{"currency": {"USD"}})
+	asrt.EqualError(err, "CurrencyCode: USD is not a valid enum")
+}
+
+func TestFormRegister(t *testing.T) {
+	asrt := assert.New(t)
+
+	d := form.NewDecoder()
+	enumform.Register(d, new(CurrencyCode))
+
+	var p formPayment
+	asrt.Nil(d.Decode(&p, url.Values{"currency": {"DIA"}, "previous": {"ASd"}, "amount": {"5"}}))
+	asrt.Equal(p.CurrencyCode.DIA, p.CurrencyCode.Get())
+	asrt.Equal(p.Previous.USD, p.Previous.Get())
+	asrt.Equal(5, p.Amount)
+
+	p = formPayment{}
+	asrt.Nil(d.Decode(&p, url.Values{"currency": {""}}))
+	asrt.Equal(enum.Const(""), p.CurrencyCode.Get())
+
+	err := d.Decode(&p, url.Values{"currency": {"USD"}})
+	errs, ok := err.(form.DecodeErrors)
+	asrt.True(ok)
+	asrt.EqualError(errs["currency"], "USD is not a valid enum")
+}
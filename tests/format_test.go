@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := enum.MustConstruct(new(Month), "March").(*Month)
+
+	asrt.Equal("March", fmt.Sprintf("%s", m))
+	asrt.Equal("March", fmt.Sprintf("%v", *m))
+	asrt.Equal(`"March"`, fmt.Sprintf("%q", m))
+	asrt.Equal("2", fmt.Sprintf("%d", m))
+	asrt.Equal("Month(March)", fmt.Sprintf("%+v", m))
+	asrt.Equal(`Month("March")`, fmt.Sprintf("%#v", m))
+	asrt.Equal("%!x(Month=March)", fmt.Sprintf("%x", m))
+}
+
+func TestFormatFlags(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := enum.MustConstruct(new(Month), "May").(*Month)
+
+	asrt.Equal("May  |", fmt.Sprintf("%-5s|", m))
+	asrt.Equal("  May", fmt.Sprintf("%5v", m))
+	asrt.Equal("004", fmt.Sprintf("%03d", m))
+}
+
+func TestFormatUnconstructed(t *testing.T) {
+	asrt := assert.New(t)
+
+	var m Month
+	asrt.Nil(m.UnmarshalJSON([]byte(`"June"`)))
+
+	asrt.Equal("-1", fmt.Sprintf("%d", m))
+	asrt.Equal("Enum(June)", fmt.Sprintf("%+v", m))
+	asrt.Equal("{June 5}", fmt.Sprint(struct {
+		M      Month
+		Amount int
+	}{m, 5}))
+}
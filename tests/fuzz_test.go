@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func FuzzCurrencyCode(f *testing.F) {
+	for _, seed := range []string{`"ASd"`, `"DIA"`, `"USD"`, `1`, `null`, `{}`, `"ASd"`, "\"\xff\"", `"`} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_ = enum.FuzzParse(new(CurrencyCode), data)
+	})
+}
+
+func FuzzPriority(f *testing.F) {
+	for _, seed := range []string{`10`, `20`, `30`, `"LOW"`, `-1`, `1e3`, `null`} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_ = enum.FuzzParse(new(Priority), data)
+	})
+}
+
+func FuzzLabelledCurrency(f *testing.F) {
+	for _, seed := range []string{`{"value":"USD"}`, `{"value":"GBP"}`, `{"label":"US Dollar"}`, `"EUR"`, `{}`} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_ = enum.FuzzParse(new(LabelledCurrency), data)
+	})
+}
+
+func TestFuzzParse(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Nil(enum.FuzzParse(new(CurrencyCode), []byte(`"ASd"`)))
+	asrt.Error(enum.FuzzParse(new(CurrencyCode), []byte(`"USD"`)))
+	asrt.Error(enum.FuzzParse(new(CurrencyCode), []byte(`[`)))
+
+	asrt.Nil(enum.FuzzParse(new(Priority), []byte(`10`)))
+	asrt.Nil(enum.FuzzParse(new(Priority), []byte(`"HIGH"`)))
+	asrt.Error(enum.FuzzParse(new(Priority), []byte(`30`)))
+
+	asrt.Nil(enum.FuzzParse(new(LabelledCurrency), []byte(`{"value":"USD","label":"ignored"}`)))
+	asrt.Nil(enum.FuzzParse(new(LabelledCurrency), []byte(`"EUR"`)))
+	asrt.Error(enum.FuzzParse(new(LabelledCurrency), []byte(`{"value":"GBP"}`)))
+}
+
+func TestUnmarshalNonString(t *testing.T) {
+	asrt := assert.New(t)
+
+	var c CurrencyCode
+	asrt.IsType(new(json.UnmarshalTypeError), json.Unmarshal([]byte(`true`), &c))
+
+	asrt.Nil(json.Unmarshal([]byte(`5`), &c))
+	asrt.EqualError(enum.Validate(&c), "5 is not a valid enum")
+}
+
+func TestUnmarshalInvalidUTF8(t *testing.T) {
+	asrt := assert.New(t)
+
+	var c CurrencyCode
+	err := c.UnmarshalJSON([]byte("\"\xff\""))
+
+	asrt.Equal("enum value is not valid UTF-8", err.Error())
+}
+
+func TestUnmarshalNull(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.MustConstruct(new(CurrencyCode), "ASd").(*CurrencyCode)
+
+	asrt.Nil(json.Unmarshal([]byte(`null`), c))
+	asrt.Equal(c.USD, c.Get())
+}
+
+func TestUnmarshalEscapes(t *testing.T) {
+	asrt := assert.New(t)
+
+	var c CurrencyCode
+	asrt.Nil(json.Unmarshal([]byte(`"DI\/A"`), &c))
+
+	asrt.Equal(enum.Const("DI/A"), c.Get())
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumgin"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type ginPayment struct {
+	CurrencyCode CurrencyCode  `json:"currency_code" form:"currency"`
+	Previous     *CurrencyCode `json:"previous" form:"previous"`
+}
+
+func ginServer(handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/payments", handler)
+	r.GET("/payments", handler)
+	return r
+}
+
+func TestGinBind(t *testing.T) {
+	asrt := assert.New(t)
+
+	var got ginPayment
+	r := ginServer(func(c *gin.Context) {
+		got = ginPayment{}
+		if enumgin.Bind(c, &got) {
+			c.Status(http.StatusNoContent)
+		}
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/payments?currency=DIA&previous=ASd", nil))
+	asrt.Equal(http.StatusNoContent, w.Code)
+	asrt.Equal(enum.Const("DIA"), got.CurrencyCode.Get())
+	asrt.Equal(enum.Const("ASd"), got.Previous.Get())
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/payments?currency=USD", nil))
+	asrt.Equal(http.StatusBadRequest, w.Code)
+	asrt.JSONEq(`{"errors":[{"field":"currency_code","message":"USD is not a valid enum"}]}`, w.Body.String())
+
+	req := httptest.NewRequest(http.MethodPost, "/payments", strings.NewReader(`{"currency_code":"DIA","previous":"USD"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	asrt.Equal(http.StatusBadRequest, w.Code)
+
+	var resp enumgin.ErrorResponse
+	asrt.Nil(json.Unmarshal(w.Body.Bytes(), &resp))
+	asrt.Equal([]enumgin.FieldError{{Field: "previous", Message: "USD is not a valid enum"}}, resp.Errors)
+}
+
+func TestGinValidator(t *testing.T) {
+	asrt := assert.New(t)
+
+	old := binding.Validator
+	binding.Validator = enumgin.NewValidator(old)
+	defer func() { binding.Validator = old }()
+
+	r := ginServer(func(c *gin.Context) {
+		var p ginPayment
+		if err := c.ShouldBind(&p); err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/payments?currency=ASd", nil))
+	asrt.Equal(http.StatusNoContent, w.Code)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/payments?currency=USD", nil))
+	asrt.Equal(http.StatusBadRequest, w.Code)
+	asrt.Equal("currency_code: USD is not a valid enum", w.Body.String())
+}
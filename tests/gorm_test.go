@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumgorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+	"testing"
+)
+
+func (GormCurrency) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return enumgorm.DBDataType(db, new(GormCurrency))
+}
+
+type GormCurrency struct {
+	enum.Enum
+	USD enum.Const
+	EUR enum.Const
+	DEM enum.Const `retired:""`
+}
+
+type GormPayment struct {
+	ID       uint
+	Currency GormCurrency  `gorm:"serializer:enum"`
+	Refund   *GormCurrency `gorm:"serializer:enum"`
+}
+
+func gormDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&GormPayment{}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestGormRoundTrip(t *testing.T) {
+	asrt := assert.New(t)
+
+	db := gormDB(t)
+	p := GormPayment{Currency: *enum.MustConstruct(new(GormCurrency), "EUR").(*GormCurrency)}
+	asrt.Nil(db.Create(&p).Error)
+
+	var out GormPayment
+	asrt.Nil(db.First(&out, p.ID).Error)
+	asrt.Equal(enum.Const("EUR"), out.Currency.Get())
+	asrt.Nil(out.Currency.Set(out.Currency.USD))
+	asrt.Nil(out.Refund)
+
+	var raw *string
+	asrt.Nil(db.Raw("SELECT refund FROM gorm_payments").Scan(&raw).Error)
+	asrt.Nil(raw)
+}
+
+func TestGormValidatesOnRead(t *testing.T) {
+	asrt := assert.New(t)
+
+	db := gormDB(t)
+	asrt.Nil(db.Exec("INSERT INTO gorm_payments (currency) VALUES ('DEM'), ('GBP')").Error)
+
+	var out GormPayment
+	asrt.Nil(db.First(&out, 1).Error)
+	asrt.True(out.Currency.IsRetired())
+
+	var invalid GormPayment
+	err := db.First(&invalid, 2).Error
+	asrt.EqualError(err, "Currency: GBP is not a valid enum")
+}
+
+func TestGormValidatesOnWrite(t *testing.T) {
+	asrt := assert.New(t)
+
+	db := gormDB(t)
+	var c GormCurrency
+	asrt.Nil(c.UnmarshalJSON([]byte(`"GBP"`)))
+
+	err := db.Create(&GormPayment{Currency: c}).Error
+	asrt.Equal([]enum.InvalidEnumError{{Type: "GormCurrency", Value: "GBP", Field: "Currency"}}, enum.InvalidValuesIn(err))
+}
+
+func TestGormDataType(t *testing.T) {
+	asrt := assert.New(t)
+
+	db := gormDB(t)
+	var ddl string
+	asrt.Nil(db.Raw("SELECT sql FROM sqlite_master WHERE name = 'gorm_payments'").Scan(&ddl).Error)
+	asrt.Contains(ddl, "`currency` varchar(3)")
+}
@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type GroupedCurrency struct {
+	enum.Enum
+	USD enum.Const `group:"fiat"`
+	BTC enum.Const `enum:",group=crypto"`
+	EUR enum.Const `group:"fiat"`
+	DEM enum.Const `group:"fiat" deprecated:"use EUR"`
+	XAU enum.Const
+}
+
+func TestGroup(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(GroupedCurrency)).(*GroupedCurrency)
+
+	asrt.Equal("fiat", c.Group(c.USD))
+	asrt.Equal("crypto", c.Group(c.BTC))
+	asrt.Equal("", c.Group(c.XAU))
+	asrt.Equal("", c.Group("JPY"))
+
+	asrt.Equal([]enum.Const{"USD", "EUR"}, c.ByGroup("fiat"))
+	asrt.Equal([]enum.Const{"BTC"}, c.ByGroup("crypto"))
+	asrt.Equal([]enum.Const{"XAU"}, c.ByGroup(""))
+	asrt.Nil(c.ByGroup("metal"))
+
+	desc, err := enum.Describe(c)
+	asrt.Nil(err)
+	d, ok := desc.Lookup(c.DEM)
+	asrt.True(ok)
+	asrt.Equal("fiat", d.Group)
+}
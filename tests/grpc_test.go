@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum/enumgrpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+type paymentRequest struct {
+	CurrencyCode CurrencyCode   `json:"currency_code"`
+	History      []CurrencyCode `json:"history"`
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	asrt := assert.New(t)
+
+	interceptor := enumgrpc.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/payments.Payments/Create"}
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "ok", nil
+	}
+
+	var req paymentRequest
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"ASd","history":["DIA"]}`), &req))
+	resp, err := interceptor(context.Background(), &req, info, handler)
+	asrt.Nil(err)
+	asrt.Equal("ok", resp)
+	asrt.True(called)
+
+	called = false
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"USD","history":["DIA","Random"]}`), &req))
+	_, err = interceptor(context.Background(), &req, info, handler)
+	asrt.False(called)
+
+	st, ok := status.FromError(err)
+	asrt.True(ok)
+	asrt.Equal(codes.InvalidArgument, st.Code())
+	asrt.Len(st.Details(), 1)
+
+	br := st.Details()[0].(*errdetails.BadRequest)
+	asrt.Len(br.FieldViolations, 2)
+	asrt.Equal("currency_code", br.FieldViolations[0].Field)
+	asrt.Equal("history[1]", br.FieldViolations[1].Field)
+}
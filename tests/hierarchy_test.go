@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type Region struct {
+	enum.Enum
+	Europe  enum.Const `enum:"EUROPE"`
+	France  enum.Const `enum:"FR" parent:"EUROPE"`
+	Paris   enum.Const `enum:"PARIS,parent=FR"`
+	Germany enum.Const `enum:"DE" parent:"EUROPE"`
+	Asia    enum.Const `enum:"ASIA"`
+	Japan   enum.Const `enum:"JP" parent:"ASIA"`
+}
+
+type unknownParent struct {
+	enum.Enum
+	France enum.Const `enum:"FR" parent:"EUROPE"`
+}
+
+type parentCycle struct {
+	enum.Enum
+	A enum.Const `parent:"B"`
+	B enum.Const `parent:"C"`
+	C enum.Const `parent:"A"`
+}
+
+func TestHierarchy(t *testing.T) {
+	asrt := assert.New(t)
+
+	r := enum.New(new(Region)).(*Region)
+
+	p, ok := r.Parent(r.Paris)
+	asrt.True(ok)
+	asrt.Equal(r.France, p)
+	_, ok = r.Parent(r.Europe)
+	asrt.False(ok)
+	_, ok = r.Parent("MARS")
+	asrt.False(ok)
+
+	asrt.Equal([]enum.Const{"FR", "DE"}, r.Children(r.Europe))
+	asrt.Equal([]enum.Const{"PARIS"}, r.Children(r.France))
+	asrt.Nil(r.Children(r.Japan))
+
+	asrt.True(r.IsWithin(r.Paris, r.Europe))
+	asrt.True(r.IsWithin(r.Europe, r.Europe))
+	asrt.False(r.IsWithin(r.Japan, r.Europe))
+	asrt.False(r.IsWithin(r.Europe, r.France))
+	asrt.False(r.IsWithin("MARS", "MARS"))
+}
+
+func TestHierarchyInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := enum.Construct(new(unknownParent), "")
+	asrt.EqualError(err, "parent EUROPE of France is not a value of the enum")
+
+	_, err = enum.Construct(new(parentCycle), "")
+	asrt.EqualError(err, "A is its own ancestor")
+}
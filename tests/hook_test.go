@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type hookConfig struct {
+	CurrencyCode CurrencyCode  `mapstructure:"currency_code"`
+	Fallback     *CurrencyCode `mapstructure:"fallback"`
+}
+
+func decodeHookConfig(input map[string]interface{}) (hookConfig, error) {
+	var cfg hookConfig
+	d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: enum.DecodeHookFunc(),
+		Result:     &cfg,
+	})
+	if err != nil {
+		return cfg, err
+	}
+	return cfg, d.Decode(input)
+}
+
+func TestDecodeHook(t *testing.T) {
+	asrt := assert.New(t)
+
+	cfg, err := decodeHookConfig(map[string]interface{}{
+		"currency_code": "ASd",
+		"fallback":      "DIA",
+	})
+
+	asrt.Nil(err)
+	asrt.Equal(cfg.CurrencyCode.USD, cfg.CurrencyCode.Get())
+	asrt.Equal(cfg.Fallback.DIA, cfg.Fallback.Get())
+	asrt.Nil(cfg.CurrencyCode.Set(cfg.CurrencyCode.DIA))
+}
+
+type priorityConfig struct {
+	Priority Priority  `mapstructure:"priority"`
+	Fallback *Priority `mapstructure:"fallback"`
+}
+
+func TestDecodeHookCodes(t *testing.T) {
+	asrt := assert.New(t)
+
+	var cfg priorityConfig
+	d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: enum.DecodeHookFunc(),
+		Result:     &cfg,
+	})
+	asrt.Nil(err)
+	asrt.Nil(d.Decode(map[string]interface{}{"priority": 20, "fallback": float64(10)}))
+	asrt.Equal(cfg.Priority.High, cfg.Priority.Get())
+	asrt.Equal(cfg.Fallback.Low, cfg.Fallback.Get())
+
+	err = d.Decode(map[string]interface{}{"priority": 30})
+	asrt.Error(err)
+	asrt.Contains(err.Error(), "30 is not a valid code for Priority")
+
+	err = d.Decode(map[string]interface{}{"priority": 10.5})
+	asrt.Error(err)
+	asrt.Contains(err.Error(), "10.5 is not a valid code for Priority")
+
+	err = d.Decode(map[string]interface{}{"priority": uint64(1) << 63})
+	asrt.Error(err)
+	asrt.Contains(err.Error(), "9223372036854775808 is not a valid code for Priority")
+}
+
+func TestDecodeHookNumberNotCoded(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := decodeHookConfig(map[string]interface{}{
+		"currency_code": 0,
+	})
+
+	asrt.Error(err)
+	asrt.Contains(err.Error(), "cannot decode the number 0 into CurrencyCode")
+}
+
+type looseHookConfig struct {
+	Status   LooseStatus `mapstructure:"status"`
+	Currency *LegacyCode `mapstructure:"currency"`
+}
+
+func TestDecodeHookLooseAndCodec(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Nil(enum.RegisterCodec(new(LegacyCode), isoNumeric{}))
+	defer enum.RegisterCodec(new(LegacyCode), nil)
+
+	var cfg looseHookConfig
+	d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: enum.DecodeHookFunc(),
+		Result:     &cfg,
+	})
+	asrt.Nil(err)
+	asrt.Nil(d.Decode(map[string]interface{}{"status": "Enabled", "currency": "978"}))
+	asrt.Equal(cfg.Status.Active, cfg.Status.Get())
+	asrt.Equal(cfg.Currency.EUR, cfg.Currency.Get())
+
+	err = d.Decode(map[string]interface{}{"currency": "EUR"})
+	asrt.Error(err)
+	asrt.Contains(err.Error(), "unknown numeric code EUR")
+}
+
+func TestDecodeHookInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := decodeHookConfig(map[string]interface{}{
+		"currency_code": "USD",
+	})
+
+	asrt.Error(err)
+	asrt.Contains(err.Error(), "USD is not a valid enum")
+}
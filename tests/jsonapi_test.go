@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumjsonapi"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type apiOrder struct {
+	ID       string        `jsonapi:"primary,orders"`
+	Currency CurrencyCode  `jsonapi:"attr,currency"`
+	Refund   *CurrencyCode `jsonapi:"attr,refund"`
+	Total    int           `jsonapi:"attr,total"`
+	Items    []*apiItem    `jsonapi:"relation,items"`
+}
+
+type apiItem struct {
+	ID       int          `jsonapi:"primary,items"`
+	Currency CurrencyCode `jsonapi:"attr,currency"`
+}
+
+func TestJSONAPIMarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	order := &apiOrder{ID: "1", Total: 5, Items: []*apiItem{{ID: 7}}}
+	enum.MustConstruct(&order.Currency, "DIA")
+	enum.MustConstruct(&order.Items[0].Currency, "ASd")
+
+	var b bytes.Buffer
+	asrt.Nil(enumjsonapi.MarshalPayload(&b, order))
+	asrt.Contains(b.String(), `"attributes":{"currency":"DIA","refund":null,"total":5}`)
+	asrt.Contains(b.String(), `"attributes":{"currency":"ASd"}`)
+}
+
+func TestJSONAPIUnmarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	body := `{
+		"data": {"type": "orders", "id": "1", "attributes": {"currency": "DIA", "refund": "ASd", "total": 5},
+			"relationships": {"items": {"data": [{"type": "items", "id": "7"}]}}},
+		"included": [{"type": "items", "id": "7", "attributes": {"currency": "ASd"}}]
+	}`
+	var order apiOrder
+	asrt.Nil(enumjsonapi.UnmarshalPayload(strings.NewReader(body), &order))
+	asrt.Equal(order.Currency.DIA, order.Currency.Get())
+	asrt.Equal(order.Refund.USD, order.Refund.Get())
+	asrt.Equal(5, order.Total)
+	asrt.Len(order.Items, 1)
+	asrt.Equal(order.Items[0].Currency.USD, order.Items[0].Currency.Get())
+
+	body = `{"data": {"type": "orders", "id": "1", "attributes": {"currency": "USD"}}}`
+	err := enumjsonapi.UnmarshalPayload(strings.NewReader(body), &apiOrder{})
+	asrt.ErrorContains(err, "USD is not a valid enum")
+}
+
+func TestJSONAPIUnmarshalMany(t *testing.T) {
+	asrt := assert.New(t)
+
+	body := `{"data": [
+		{"type": "orders", "id": "1", "attributes": {"currency": "DIA"}},
+		{"type": "orders", "id": "2", "attributes": {"currency": "ASd"}}
+	]}`
+	models, err := enumjsonapi.UnmarshalManyPayload(strings.NewReader(body), reflect.TypeOf(new(apiOrder)))
+	asrt.Nil(err)
+	asrt.Len(models, 2)
+	asrt.Equal(enum.Const("DIA"), models[0].(*apiOrder).Currency.Get())
+	asrt.Equal(enum.Const("ASd"), models[1].(*apiOrder).Currency.Get())
+}
@@ -0,0 +1,55 @@
+package tests
+
+import (
+	"github.com/knadh/koanf/providers/confmap"
+	"github.com/knadh/koanf/v2"
+	"github.com/stretchr/testify/assert"
+	"go-enum/enumkoanf"
+	"testing"
+)
+
+type koanfConfig struct {
+	Billing struct {
+		Currency CurrencyCode   `koanf:"currency"`
+		Tagged   TaggedCurrency `koanf:"tagged"`
+		Fallback *TaggedCurrency `koanf:"fallback"`
+	} `koanf:"billing"`
+	Name string `koanf:"name"`
+}
+
+func TestKoanfDefaults(t *testing.T) {
+	asrt := assert.New(t)
+
+	p := enumkoanf.Defaults(&koanfConfig{})
+	m, err := p.Read()
+	asrt.Nil(err)
+	asrt.Equal(map[string]interface{}{
+		"billing": map[string]interface{}{"tagged": "USD", "fallback": "USD"},
+	}, m)
+
+	_, err = p.ReadBytes()
+	asrt.NotNil(err)
+}
+
+func TestKoanfUnmarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	k := koanf.New(".")
+	asrt.Nil(k.Load(enumkoanf.Defaults(&koanfConfig{}), nil))
+	asrt.Nil(k.Load(confmap.Provider(map[string]interface{}{
+		"billing.currency": "DIA",
+		"billing.fallback": "CUSTOM",
+		"name":             "shop",
+	}, "."), nil))
+
+	var cfg koanfConfig
+	asrt.Nil(enumkoanf.Unmarshal(k, "", &cfg))
+	asrt.Equal(cfg.Billing.Currency.DIA, cfg.Billing.Currency.Get())
+	asrt.Equal(cfg.Billing.Tagged.USD, cfg.Billing.Tagged.Get())
+	asrt.Equal(cfg.Billing.Fallback.Custom, cfg.Billing.Fallback.Get())
+	asrt.Equal("shop", cfg.Name)
+
+	asrt.Nil(k.Load(confmap.Provider(map[string]interface{}{"billing.currency": "USD"}, "."), nil))
+	err := enumkoanf.Unmarshal(k, "", &koanfConfig{})
+	asrt.ErrorContains(err, "USD is not a valid enum")
+}
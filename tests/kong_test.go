@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"bytes"
+	"github.com/alecthomas/kong"
+	"github.com/stretchr/testify/assert"
+	"go-enum/enumkong"
+	"testing"
+)
+
+type kongCLI struct {
+	Currency CurrencyCode  `help:"the currency to charge in"`
+	Refund   *CurrencyCode `help:"refund in ${enum_values}"`
+	Payee    CurrencyCode  `arg:"" optional:""`
+}
+
+func kongParser(t *testing.T, cli *kongCLI, out *bytes.Buffer) *kong.Kong {
+	parser, err := kong.New(cli, kong.Name("pay"), kong.Writers(out, out), kong.Exit(func(int) {}), enumkong.Option())
+	assert.Nil(t, err)
+	return parser
+}
+
+func TestKongOption(t *testing.T) {
+	asrt := assert.New(t)
+
+	var cli kongCLI
+	parser := kongParser(t, &cli, new(bytes.Buffer))
+	_, err := parser.Parse([]string{"--currency", "DIA", "--refund", "ASd", "ASd"})
+	asrt.Nil(err)
+	asrt.Equal(cli.Currency.DIA, cli.Currency.Get())
+	asrt.Equal(cli.Refund.USD, cli.Refund.Get())
+	asrt.Equal(cli.Payee.USD, cli.Payee.Get())
+
+	_, err = parser.Parse([]string{"--currency", "USD"})
+	asrt.ErrorContains(err, "USD is not a valid enum")
+}
+
+func TestKongHelp(t *testing.T) {
+	asrt := assert.New(t)
+
+	out := new(bytes.Buffer)
+	var cli kongCLI
+	parser := kongParser(t, &cli, out)
+	_, _ = parser.Parse([]string{"--help"})
+	asrt.Contains(out.String(), "the currency to charge in (one of ASd, DIA)")
+	asrt.Contains(out.String(), "refund in ASd, DIA")
+	asrt.NotContains(out.String(), "refund in ASd, DIA (one of")
+}
+
+func TestKongMapperValue(t *testing.T) {
+	asrt := assert.New(t)
+
+	var cc CurrencyCode
+	asrt.Nil(enumkong.Value(&cc).Decode(&kong.DecodeContext{Scan: kong.Scan("DIA")}))
+	asrt.Equal(cc.DIA, cc.Get())
+	asrt.NotNil(enumkong.Value(&cc).Decode(&kong.DecodeContext{Scan: kong.Scan("USD")}))
+}
@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type RenamedCurrency struct {
+	enum.Enum
+	USD enum.Const `legacy:"US_DOLLAR, USDOLLAR"`
+	EUR enum.Const
+}
+
+type renamedPayment struct {
+	Currency RenamedCurrency `json:"currency" xml:"currency"`
+}
+
+func TestLegacyUnmarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	cc := enum.New(new(RenamedCurrency)).(*RenamedCurrency)
+	asrt.Nil(json.Unmarshal([]byte(`"USDOLLAR"`), cc))
+	asrt.Equal(cc.USD, cc.Get())
+
+	out, err := json.Marshal(cc)
+	asrt.Nil(err)
+	asrt.Equal(`"USD"`, string(out))
+
+	cc = enum.New(new(RenamedCurrency)).(*RenamedCurrency)
+	asrt.Nil(cc.UnmarshalText([]byte("US_DOLLAR")))
+	asrt.Equal(cc.USD, cc.Get())
+
+	var p renamedPayment
+	asrt.Nil(xml.Unmarshal([]byte(`<payment><currency>US_DOLLAR</currency></payment>`), &p))
+	asrt.Equal(enum.Const("US_DOLLAR"), p.Currency.Get())
+	asrt.Nil(enum.ValidateAll(&p))
+	asrt.Equal(p.Currency.USD, p.Currency.Get())
+}
+
+func TestLegacyUnconstructed(t *testing.T) {
+	asrt := assert.New(t)
+
+	var p renamedPayment
+	asrt.Nil(json.Unmarshal([]byte(`{"currency":"USDOLLAR"}`), &p))
+	asrt.Nil(enum.Validate(&p.Currency))
+	asrt.Equal(p.Currency.USD, p.Currency.Get())
+
+	out, err := json.Marshal(p)
+	asrt.Nil(err)
+	asrt.Equal(`{"currency":"USD"}`, string(out))
+}
+
+func TestLegacyParse(t *testing.T) {
+	asrt := assert.New(t)
+
+	c, err := enum.Parse(new(RenamedCurrency), "us_dollar", enum.CaseInsensitive())
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("USD"), c)
+
+	cc := enum.New(new(RenamedCurrency)).(*RenamedCurrency)
+	d, err := enum.Describe(cc)
+	asrt.Nil(err)
+	asrt.Equal([]enum.Const{"US_DOLLAR", "USDOLLAR"}, d.Consts()[0].Legacy)
+}
+
+func TestLegacyClash(t *testing.T) {
+	asrt := assert.New(t)
+
+	type current struct {
+		enum.Enum
+		USD enum.Const `legacy:"EUR"`
+		EUR enum.Const
+	}
+	type shared struct {
+		enum.Enum
+		USD enum.Const `legacy:"DOLLAR"`
+		CAD enum.Const `legacy:"DOLLAR"`
+	}
+
+	_, err := enum.NewE(new(current))
+	asrt.EqualError(err, "legacy value EUR of USD is already a value of the enum")
+	_, err = enum.NewE(new(shared))
+	asrt.EqualError(err, "legacy value DOLLAR is used by both USD and CAD")
+}
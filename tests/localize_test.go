@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumtext"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+type LocalizedCurrency struct {
+	enum.Enum
+	USD enum.Const `display:"US Dollar"`
+	EUR enum.Const
+	CAD enum.Const
+}
+
+func init() {
+	_ = enum.RegisterTranslations(new(LocalizedCurrency), "fr", map[enum.Const]string{
+		"USD": "Dollar américain",
+		"EUR": "Euro",
+	})
+	_ = enum.RegisterTranslations(new(LocalizedCurrency), "fr_CA", map[enum.Const]string{
+		"CAD": "Dollar canadien",
+	})
+	_ = enum.RegisterTranslations(new(LocalizedCurrency), "de", map[enum.Const]string{
+		"USD": "US-Dollar",
+	})
+}
+
+func TestLocalize(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.MustConstruct(new(LocalizedCurrency), "USD").(*LocalizedCurrency)
+
+	asrt.Equal("Dollar américain", enum.Localize(c, "fr"))
+	asrt.Equal("Dollar américain", enum.Localize(c, "fr-CA"))
+	asrt.Equal("US-Dollar", enum.Localize(c, "DE"))
+	asrt.Equal("US Dollar", enum.Localize(c, "ja"))
+
+	c.MustSet(c.CAD)
+	asrt.Equal("Dollar canadien", enum.Localize(c, "fr-CA"))
+	asrt.Equal("CAD", enum.Localize(c, "fr"))
+}
+
+func TestLanguages(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Equal([]string{"de", "fr", "fr-ca"}, enum.Languages(new(LocalizedCurrency)))
+	asrt.Empty(enum.Languages(new(CurrencyCode)))
+}
+
+func TestRegisterTranslationsInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	err := enum.RegisterTranslations(new(LocalizedCurrency), "es", map[enum.Const]string{"MXN": "Peso"})
+	asrt.EqualError(err, "MXN is not a valid enum")
+
+	err = enum.RegisterTranslations(new(LocalizedCurrency), " ", map[enum.Const]string{"USD": "Dólar"})
+	asrt.EqualError(err, `" " is not a valid language`)
+}
+
+func TestLocalizeDefined(t *testing.T) {
+	asrt := assert.New(t)
+
+	e, _ := Colors.New(Red)
+	asrt.Nil(enum.RegisterTranslations(e, "es", map[enum.Const]string{"RED": "rojo"}))
+
+	other, _ := Colors.New(Red)
+	asrt.Equal("rojo", enum.Localize(other, "es"))
+}
+
+func TestTextLocalize(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.MustConstruct(new(LocalizedCurrency), "USD").(*LocalizedCurrency)
+
+	asrt.Equal("Dollar américain", enumtext.Localize(c, language.MustParse("fr-CH")))
+	asrt.Equal("US-Dollar", enumtext.LocalizeAccept(c, "ja, de-AT;q=0.8, fr;q=0.5"))
+	asrt.Equal("US Dollar", enumtext.LocalizeAccept(c, "ja"))
+}
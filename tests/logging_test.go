@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"bytes"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumzap"
+	"go-enum/enumzerolog"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"testing"
+)
+
+func TestZapFields(t *testing.T) {
+	asrt := assert.New(t)
+
+	var buf bytes.Buffer
+	enc := zapcore.NewJSONEncoder(zapcore.EncoderConfig{MessageKey: "msg"})
+	l := zap.New(zapcore.NewCore(enc, zapcore.AddSync(&buf), zapcore.InfoLevel))
+	cc := enum.MustConstruct(new(CurrencyCode), "DIA").(*CurrencyCode)
+
+	l.Info("paid", enumzap.Field("currency", cc))
+	asrt.JSONEq(`{"msg":"paid","currency":"DIA"}`, buf.String())
+
+	buf.Reset()
+	l.Info("paid", enumzap.TypedField("currency", cc))
+	asrt.JSONEq(`{"msg":"paid","currency":{"value":"DIA","type":"CurrencyCode"}}`, buf.String())
+}
+
+func TestZerologFields(t *testing.T) {
+	asrt := assert.New(t)
+
+	var buf bytes.Buffer
+	l := zerolog.New(&buf)
+	cc := enum.MustConstruct(new(CurrencyCode), "ASd").(*CurrencyCode)
+
+	enumzerolog.Field(l.Info(), "currency", cc).Msg("paid")
+	asrt.JSONEq(`{"level":"info","message":"paid","currency":"ASd"}`, buf.String())
+
+	buf.Reset()
+	enumzerolog.TypedField(l.Info(), "currency", cc).Msg("paid")
+	asrt.JSONEq(`{"level":"info","message":"paid","currency":{"value":"ASd","type":"CurrencyCode"}}`, buf.String())
+}
@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type LooseStatus struct {
+	enum.Enum `loose:""`
+	Active    enum.Const `enum:"ACTIVE,alias=enabled"`
+	Closed    enum.Const `enum:"CLOSED"`
+}
+
+type CodedLooseStatus struct {
+	enum.Enum `loose:"" format:"int"`
+	Active    enum.Const `enum:"ACTIVE" code:"10"`
+	Closed    enum.Const `enum:"CLOSED" code:"20"`
+}
+
+func TestLooseUnmarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	for in, want := range map[string]enum.Const{
+		`1`:         "CLOSED",
+		`0`:         "ACTIVE",
+		`"active"`:  "ACTIVE",
+		`"Enabled"`: "ACTIVE",
+		`"CLOSED"`:  "CLOSED",
+		`"1"`:       "CLOSED",
+	} {
+		s := enum.New(new(LooseStatus)).(*LooseStatus)
+		asrt.Nil(json.Unmarshal([]byte(in), s), in)
+		asrt.Equal(want, s.Get(), in)
+	}
+
+	s := enum.MustConstruct(new(LooseStatus), "CLOSED").(*LooseStatus)
+	asrt.Nil(json.Unmarshal([]byte(`null`), s))
+	asrt.Equal(s.Closed, s.Get())
+
+	asrt.NotNil(json.Unmarshal([]byte(`7`), s))
+	asrt.NotNil(json.Unmarshal([]byte(`1.5`), s))
+	asrt.Nil(json.Unmarshal([]byte(`"Random"`), s))
+	asrt.NotNil(enum.Validate(s))
+}
+
+func TestLooseUnmarshalCodes(t *testing.T) {
+	asrt := assert.New(t)
+
+	s := enum.New(new(CodedLooseStatus)).(*CodedLooseStatus)
+	asrt.Nil(json.Unmarshal([]byte(`"20"`), s))
+	asrt.Equal(s.Closed, s.Get())
+	asrt.Nil(json.Unmarshal([]byte(`"active"`), s))
+	asrt.Equal(s.Active, s.Get())
+
+	out, err := json.Marshal(s)
+	asrt.Nil(err)
+	asrt.Equal(`10`, string(out))
+}
+
+type looseAccount struct {
+	Status LooseStatus      `json:"status"`
+	Coded  CodedLooseStatus `json:"coded"`
+}
+
+func TestLooseUnmarshalUnconstructed(t *testing.T) {
+	asrt := assert.New(t)
+
+	for in, want := range map[string]enum.Const{
+		`{"status":"active","coded":"20"}`: "ACTIVE",
+		`{"status":"Enabled","coded":20}`:  "ACTIVE",
+		`{"status":"1","coded":"closed"}`:  "CLOSED",
+		`{"status":1,"coded":"CLOSED"}`:    "CLOSED",
+	} {
+		var a looseAccount
+		asrt.Nil(json.Unmarshal([]byte(in), &a), in)
+		asrt.Nil(enum.ValidateAll(&a), in)
+		asrt.Equal(want, a.Status.Get(), in)
+		asrt.Equal(a.Coded.Closed, a.Coded.Get(), in)
+	}
+
+	var a looseAccount
+	asrt.Nil(json.Unmarshal([]byte(`{"status":"Random","coded":"CLOSED"}`), &a))
+	asrt.NotNil(enum.ValidateAll(&a))
+}
+
+func TestStrictUnmarshalRejectsNumbers(t *testing.T) {
+	asrt := assert.New(t)
+
+	cc := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	asrt.NotNil(json.Unmarshal([]byte(`1`), cc))
+}
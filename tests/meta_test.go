@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type MetaCurrency struct {
+	enum.Enum
+	USD enum.Const `meta:"symbol=$, decimals=2"`
+	JPY enum.Const `meta:"symbol=¥,decimals=0"`
+	XXX enum.Const
+}
+
+func TestMeta(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(MetaCurrency)).(*MetaCurrency)
+
+	asrt.Equal(map[string]string{"symbol": "$", "decimals": "2"}, c.Meta(c.USD))
+	asrt.Equal("0", c.Meta(c.JPY)["decimals"])
+	asrt.Nil(c.Meta(c.XXX))
+	asrt.Nil(c.Meta("GBP"))
+
+	c.Meta(c.USD)["symbol"] = "US$"
+	asrt.Equal("$", c.Meta(c.USD)["symbol"])
+}
+
+func TestDescribeMeta(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, _ := enum.Describe(new(MetaCurrency))
+	c, _ := d.Lookup("JPY")
+
+	asrt.Equal(map[string]string{"symbol": "¥", "decimals": "0"}, c.Metadata)
+}
+
+func TestMetaMalformed(t *testing.T) {
+	asrt := assert.New(t)
+
+	type BadMeta struct {
+		enum.Enum
+		USD enum.Const `meta:"symbol"`
+	}
+
+	err := enum.Validate(new(BadMeta))
+	asrt.EqualError(err, `meta tag on USD is malformed, expected key=value pairs but got "symbol"`)
+}
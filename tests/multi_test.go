@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type Feature struct {
+	enum.Enum
+	Wifi    enum.Const `enum:"WIFI"`
+	Parking enum.Const `enum:"PARKING"`
+	Pool    enum.Const `enum:"POOL"`
+	Sauna   enum.Const `enum:"SAUNA" retired:""`
+}
+
+type listing struct {
+	Features enum.Multi[Feature] `json:"features"`
+}
+
+func TestMulti(t *testing.T) {
+	asrt := assert.New(t)
+
+	m, err := enum.NewMulti(new(Feature), "POOL", "WIFI")
+	asrt.Nil(err)
+	asrt.Equal([]enum.Const{"POOL", "WIFI"}, m.Values())
+	asrt.True(m.Contains("WIFI"))
+	asrt.False(m.Contains("PARKING"))
+	asrt.Equal(2, m.Len())
+	asrt.Equal("POOL,WIFI", m.String())
+
+	asrt.EqualError(m.Add("PARKING", "POOL"), "POOL is selected more than once")
+	asrt.EqualError(m.Add("SAUNA"), "SAUNA has been retired")
+	asrt.EqualError(m.Add("GYM"), "GYM is not a valid enum")
+	asrt.Equal(2, m.Len())
+
+	m.Remove("POOL")
+	asrt.Nil(m.Add("PARKING"))
+	asrt.Equal([]enum.Const{"WIFI", "PARKING"}, m.Values())
+
+	_, err = enum.NewMulti(new(Feature), "WIFI", "WIFI")
+	asrt.EqualError(err, "WIFI is selected more than once")
+}
+
+func TestMultiAliases(t *testing.T) {
+	asrt := assert.New(t)
+
+	m, err := enum.NewMulti(new(TaggedCurrency), "dollar")
+	asrt.Nil(err)
+	asrt.Equal([]enum.Const{"USD"}, m.Values())
+	asrt.True(m.Contains("usd_legacy"))
+
+	asrt.EqualError(m.Add("USD"), "USD is selected more than once")
+	asrt.EqualError(m.Add("CUSTOM", "usd_legacy"), "usd_legacy is selected more than once")
+
+	m.Remove("usd_legacy")
+	asrt.Equal(0, m.Len())
+	asrt.False(m.Contains("USD"))
+}
+
+func TestMultiLimit(t *testing.T) {
+	asrt := assert.New(t)
+
+	var m enum.Multi[Feature]
+	m.Limit(1, 2)
+	asrt.EqualError(m.Validate(), "at least 1 values must be selected but got 0")
+	asrt.Nil(m.Add("WIFI", "POOL"))
+	asrt.Nil(m.Validate())
+	asrt.EqualError(m.Add("PARKING"), "at most 2 values can be selected but got 3")
+}
+
+func TestMultiJSON(t *testing.T) {
+	asrt := assert.New(t)
+
+	var l listing
+	asrt.Nil(json.Unmarshal([]byte(`{"features":["WIFI","POOL"]}`), &l))
+	asrt.Equal([]enum.Const{"WIFI", "POOL"}, l.Features.Values())
+
+	b, err := json.Marshal(l)
+	asrt.Nil(err)
+	asrt.Equal(`{"features":["WIFI","POOL"]}`, string(b))
+
+	asrt.Nil(json.Unmarshal([]byte(`{"features":"PARKING, WIFI"}`), &l))
+	asrt.Equal([]enum.Const{"PARKING", "WIFI"}, l.Features.Values())
+
+	asrt.EqualError(json.Unmarshal([]byte(`{"features":["WIFI","WIFI"]}`), &l), "WIFI is selected more than once")
+	asrt.Equal([]enum.Const{"PARKING", "WIFI"}, l.Features.Values())
+
+	l = listing{}
+	l.Features.Limit(1, 0)
+	asrt.EqualError(json.Unmarshal([]byte(`{"features":[]}`), &l), "at least 1 values must be selected but got 0")
+
+	b, err = json.Marshal(listing{})
+	asrt.Nil(err)
+	asrt.Equal(`{"features":[]}`, string(b))
+}
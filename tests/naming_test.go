@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type SnakeCurrency struct {
+	enum.Enum `case:"snake"`
+	UsDollar  enum.Const
+	HTTPCoin  enum.Const
+	Euro      enum.Const `enum:"EURO"`
+}
+
+func TestCaseTransforms(t *testing.T) {
+	asrt := assert.New(t)
+
+	type Lower struct {
+		enum.Enum `case:"lower"`
+		UsDollar  enum.Const
+	}
+	type Upper struct {
+		enum.Enum `case:"upper"`
+		UsDollar  enum.Const
+	}
+	type Kebab struct {
+		enum.Enum `case:"kebab"`
+		UsDollar  enum.Const
+		HTTPCoin  enum.Const
+	}
+	type Camel struct {
+		enum.Enum `case:"camel"`
+		UsDollar  enum.Const
+		HTTPCoin  enum.Const
+		Euro      enum.Const `enum:"EURO"`
+	}
+
+	asrt.Equal([]enum.Const{"us_dollar", "http_coin", "EURO"}, enum.New(new(SnakeCurrency)).GetAll())
+	asrt.Equal([]enum.Const{"usdollar"}, enum.New(new(Lower)).GetAll())
+	asrt.Equal([]enum.Const{"USDOLLAR"}, enum.New(new(Upper)).GetAll())
+	asrt.Equal([]enum.Const{"us-dollar", "http-coin"}, enum.New(new(Kebab)).GetAll())
+	asrt.Equal([]enum.Const{"usDollar", "httpCoin", "EURO"}, enum.New(new(Camel)).GetAll())
+}
+
+func TestCaseSetsFields(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.MustConstruct(new(SnakeCurrency), "us_dollar").(*SnakeCurrency)
+
+	asrt.Equal(c.UsDollar, c.Get())
+	asrt.Equal(enum.Const("http_coin"), c.HTTPCoin)
+}
+
+func TestCaseUnknown(t *testing.T) {
+	asrt := assert.New(t)
+
+	type Unknown struct {
+		enum.Enum `case:"shouting"`
+		UsDollar  enum.Const
+	}
+
+	_, err := enum.Construct(new(Unknown), "UsDollar")
+	asrt.Equal("shouting is not a known case", err.Error())
+}
@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestNewE(t *testing.T) {
+	asrt := assert.New(t)
+
+	e, err := enum.NewE(new(CurrencyCode))
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("ASd"), e.(*CurrencyCode).USD)
+
+	_, err = enum.NewE(new(duplicateValues))
+	asrt.EqualError(err, "USD is declared by both USD and Dollar")
+
+	var nilCode *CurrencyCode
+	_, err = enum.NewE(nilCode)
+	asrt.EqualError(err, "cannot set a value on an enum that has not be constructed")
+	_, err = enum.NewE(nil)
+	asrt.NotNil(err)
+}
+
+func TestNonPanickingCounterparts(t *testing.T) {
+	asrt := assert.New(t)
+
+	var nilCode *CurrencyCode
+
+	_, err := enum.Describe(nilCode)
+	asrt.NotNil(err)
+
+	_, err = enum.ValueOfE(new(duplicateValues))
+	asrt.NotNil(err)
+	v, err := enum.ValueOfE(enum.MustConstruct(new(CurrencyCode), "DIA"))
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("DIA"), v.Const())
+
+	_, err = enum.AvroSchemaE(new(duplicateValues))
+	asrt.NotNil(err)
+
+	_, err = enum.DefineE[Color]("red", "red")
+	asrt.EqualError(err, "red is declared more than once")
+
+	_, err = enum.DiffE(new(CurrencyCode), nilCode)
+	asrt.NotNil(err)
+
+	_, err = enum.AllOfE(new(duplicateValues))
+	asrt.NotNil(err)
+}
@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type Priority struct {
+	enum.Enum `format:"int"`
+	Low       enum.Const `enum:"LOW" code:"10"`
+	High      enum.Const `enum:"HIGH" code:"20"`
+}
+
+type Weekday struct {
+	enum.Enum `format:"int"`
+	Monday    enum.Const
+	Tuesday   enum.Const
+}
+
+type Quarter struct {
+	enum.Enum `format:"int" start:"1"`
+	Q4        enum.Const `order:"1"`
+	Q1        enum.Const
+	Q2        enum.Const
+}
+
+type badStart struct {
+	enum.Enum `format:"int" start:"one"`
+	Low       enum.Const
+}
+
+type badFormat struct {
+	enum.Enum `format:"hex"`
+	Low       enum.Const
+}
+
+type missingCode struct {
+	enum.Enum `format:"int"`
+	Low       enum.Const `code:"1"`
+	High      enum.Const
+}
+
+type duplicateCode struct {
+	enum.Enum
+	Low  enum.Const `code:"1"`
+	High enum.Const `code:"1"`
+}
+
+type priorityTask struct {
+	Priority *Priority `json:"priority"`
+}
+
+func TestNumericMarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	p := enum.MustConstruct(new(Priority), "HIGH").(*Priority)
+	b, err := json.Marshal(p)
+	asrt.Nil(err)
+	asrt.Equal(`20`, string(b))
+
+	b, err = json.Marshal(enum.New(new(Priority)))
+	asrt.Nil(err)
+	asrt.Equal(`null`, string(b))
+
+	b, err = json.Marshal(enum.ValueOf(p))
+	asrt.Nil(err)
+	asrt.Equal(`20`, string(b))
+
+	w := enum.MustConstruct(new(Weekday), "Tuesday").(*Weekday)
+	b, err = json.Marshal(w)
+	asrt.Nil(err)
+	asrt.Equal(`1`, string(b))
+
+	code, ok := p.Code(p.Low)
+	asrt.True(ok)
+	asrt.Equal(10, code)
+}
+
+func TestNumericAutoNumbering(t *testing.T) {
+	asrt := assert.New(t)
+
+	q := enum.MustConstruct(new(Quarter), "Q2").(*Quarter)
+	b, err := json.Marshal(q)
+	asrt.Nil(err)
+	asrt.Equal(`3`, string(b))
+
+	code, ok := q.Code(q.Q4)
+	asrt.True(ok)
+	asrt.Equal(1, code)
+
+	asrt.Nil(json.Unmarshal([]byte(`2`), q))
+	asrt.Equal(q.Q1, q.Get())
+	asrt.Equal([]enum.Const{"Q4", "Q1", "Q2"}, q.GetAll())
+}
+
+func TestNumericUnmarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	task := priorityTask{Priority: enum.New(new(Priority)).(*Priority)}
+	asrt.Nil(json.Unmarshal([]byte(`{"priority":10}`), &task))
+	asrt.Equal(enum.Const("LOW"), task.Priority.Get())
+
+	asrt.Nil(json.Unmarshal([]byte(`{"priority":"HIGH"}`), &task))
+	asrt.Equal(enum.Const("HIGH"), task.Priority.Get())
+
+	err := json.Unmarshal([]byte(`{"priority":30}`), &task)
+	asrt.EqualError(err, "30 is not a valid code for Priority")
+
+	var unconstructed priorityTask
+	asrt.Nil(json.Unmarshal([]byte(`{"priority":10}`), &unconstructed))
+	asrt.Nil(enum.Validate(unconstructed.Priority))
+	asrt.Equal(enum.Const("LOW"), unconstructed.Priority.Get())
+
+	unconstructed = priorityTask{}
+	asrt.Nil(json.Unmarshal([]byte(`{"priority":30}`), &unconstructed))
+	asrt.EqualError(enum.Validate(unconstructed.Priority), "30 is not a valid code for Priority")
+}
+
+func TestNumericInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := enum.Construct(new(badFormat), "")
+	asrt.EqualError(err, `"hex" is not a valid format, expected int, object, proto or string`)
+
+	_, err = enum.Construct(new(badStart), "")
+	asrt.EqualError(err, `start tag on badStart must be an integer but got "one"`)
+
+	_, err = enum.Construct(new(missingCode), "")
+	asrt.EqualError(err, "code tag missing on High, either every Const or none must have one")
+
+	_, err = enum.Construct(new(duplicateCode), "")
+	asrt.EqualError(err, "code 1 is used by both Low and High")
+}
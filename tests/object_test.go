@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type LabelledCurrency struct {
+	enum.Enum `format:"object"`
+	USD       enum.Const `display:"US Dollar"`
+	EUR       enum.Const
+}
+
+func TestObjectMarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.MustConstruct(new(LabelledCurrency), "USD").(*LabelledCurrency)
+	b, err := json.Marshal(c)
+	asrt.Nil(err)
+	asrt.Equal(`{"value":"USD","label":"US Dollar"}`, string(b))
+
+	b, err = json.Marshal(enum.ValueOf(c))
+	asrt.Nil(err)
+	asrt.Equal(`{"value":"USD","label":"US Dollar"}`, string(b))
+
+	asrt.Nil(c.Set(c.EUR))
+	b, err = json.Marshal(c)
+	asrt.Nil(err)
+	asrt.Equal(`{"value":"EUR","label":"EUR"}`, string(b))
+
+	b, err = json.Marshal(enum.New(new(LabelledCurrency)))
+	asrt.Nil(err)
+	asrt.Equal(`null`, string(b))
+}
+
+func TestObjectUnmarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(LabelledCurrency)).(*LabelledCurrency)
+	asrt.Nil(json.Unmarshal([]byte(`{"value":"USD","label":"ignored"}`), c))
+	asrt.Equal(c.USD, c.Get())
+
+	asrt.Nil(json.Unmarshal([]byte(`"EUR"`), c))
+	asrt.Equal(c.EUR, c.Get())
+
+	asrt.EqualError(json.Unmarshal([]byte(`{"label":"US Dollar"}`), c), "enum object for LabelledCurrency is missing its value")
+
+	asrt.Nil(json.Unmarshal([]byte(`{"value":"GBP"}`), c))
+	asrt.EqualError(enum.Validate(c), "GBP is not a valid enum")
+}
+
+type labelledPayment struct {
+	Currency LabelledCurrency `json:"currency"`
+}
+
+func TestObjectRoundTrip(t *testing.T) {
+	asrt := assert.New(t)
+
+	in := labelledPayment{Currency: *enum.MustConstruct(new(LabelledCurrency), "USD").(*LabelledCurrency)}
+	b, err := json.Marshal(in)
+	asrt.Nil(err)
+
+	var out labelledPayment
+	asrt.Nil(json.Unmarshal(b, &out))
+	asrt.Nil(enum.Validate(&out.Currency))
+	asrt.Equal(out.Currency.USD, out.Currency.Get())
+
+	out = labelledPayment{}
+	asrt.Nil(json.Unmarshal([]byte(`{"currency":{"label":"US Dollar"}}`), &out))
+	asrt.EqualError(enum.Validate(&out.Currency), "enum object for LabelledCurrency is missing its value")
+}
@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type ObservedStatus struct {
+	enum.Enum
+	Open   enum.Const `enum:"OPEN"`
+	Closed enum.Const `enum:"CLOSED" transitions:""`
+}
+
+func TestOnChange(t *testing.T) {
+	asrt := assert.New(t)
+
+	s := enum.New(new(ObservedStatus)).(*ObservedStatus)
+	var seen []string
+	s.OnChange(func(old, new enum.Const) {
+		seen = append(seen, string(old)+">"+string(new))
+	})
+
+	s.MustSet(s.Open)
+	asrt.Nil(s.Set(s.Open))
+	asrt.Nil(s.Set(s.Closed))
+	asrt.Error(s.Set(s.Open))
+	asrt.Error(s.Set("BOGUS"))
+	asrt.Nil(s.UnmarshalJSON([]byte(`"OPEN"`)))
+
+	asrt.Equal([]string{">OPEN", "OPEN>OPEN", "OPEN>CLOSED"}, seen)
+}
+
+func TestOnTypeChange(t *testing.T) {
+	asrt := assert.New(t)
+
+	type Audited struct {
+		enum.Enum
+		A enum.Const
+		B enum.Const
+	}
+
+	var seen []string
+	asrt.Nil(enum.OnTypeChange(new(Audited), func(old, new enum.Const) {
+		seen = append(seen, "type:"+string(new))
+	}))
+
+	first := enum.New(new(Audited)).(*Audited)
+	second := enum.New(new(Audited)).(*Audited)
+	second.OnChange(func(old, new enum.Const) {
+		seen = append(seen, "instance:"+string(new))
+	})
+
+	first.MustSet(first.A)
+	second.MustSet(second.B)
+
+	asrt.Equal([]string{"type:A", "type:B", "instance:B"}, seen)
+}
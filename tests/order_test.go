@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"strings"
+	"testing"
+)
+
+type Severity struct {
+	enum.Enum
+	Warning  enum.Const `enum:"WARNING" order:"2"`
+	Unknown  enum.Const `enum:"UNKNOWN"`
+	Info     enum.Const `enum:"INFO" order:"1"`
+	Critical enum.Const `enum:"CRITICAL" order:"3"`
+	Debug    enum.Const `enum:"DEBUG" order:"1"`
+}
+
+func TestOrderTag(t *testing.T) {
+	asrt := assert.New(t)
+
+	s := enum.New(new(Severity)).(*Severity)
+
+	asrt.Equal([]enum.Const{"INFO", "DEBUG", "WARNING", "CRITICAL", "UNKNOWN"}, s.GetAll())
+
+	d, _ := enum.Describe(s)
+	c, _ := d.Lookup("CRITICAL")
+	asrt.Equal(3, c.Ordinal)
+}
+
+func TestDeclarationOrder(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := enum.New(new(Month)).(*Month)
+
+	asrt.Equal(enum.Const("January"), m.GetAll()[0])
+	asrt.Equal(enum.Const("December"), m.GetAll()[11])
+}
+
+func TestGetAllSorted(t *testing.T) {
+	asrt := assert.New(t)
+
+	s := enum.New(new(Severity)).(*Severity)
+	sorted := s.GetAllSorted(func(a, b enum.Const) bool {
+		return strings.Compare(string(a), string(b)) < 0
+	})
+
+	asrt.Equal([]enum.Const{"CRITICAL", "DEBUG", "INFO", "UNKNOWN", "WARNING"}, sorted)
+	asrt.Equal(enum.Const("INFO"), s.GetAll()[0])
+}
+
+func TestOrderTagInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	type BadOrder struct {
+		enum.Enum
+		A enum.Const `order:"first"`
+	}
+
+	asrt.EqualError(enum.Validate(new(BadOrder)), `order tag on A must be an integer but got "first"`)
+}
@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"sync"
+	"testing"
+)
+
+type FuzzyRegion struct {
+	enum.Enum
+	NorthAmerica enum.Const `enum:"north_america"`
+	Europe       enum.Const `enum:"EUROPE"`
+	Asia         enum.Const `enum:"asia" retired:""`
+	EU           enum.Const `enum:"E-U"`
+	EUAlt        enum.Const `enum:"e.u"`
+}
+
+func TestParseFuzzy(t *testing.T) {
+	asrt := assert.New(t)
+
+	for _, s := range []string{"north_america", "North America", " NORTH-AMERICA ", "northamerica"} {
+		c, err := enum.ParseFuzzy(new(FuzzyRegion), s)
+		asrt.Nil(err, s)
+		asrt.Equal(enum.Const("north_america"), c)
+	}
+
+	c, err := enum.ParseFuzzy(new(FuzzyRegion), "europe\t")
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("EUROPE"), c)
+}
+
+func TestParseFuzzyExactFirst(t *testing.T) {
+	asrt := assert.New(t)
+
+	c, err := enum.ParseFuzzy(new(FuzzyRegion), "e.u")
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("e.u"), c)
+
+	_, err = enum.ParseFuzzy(new(FuzzyRegion), "eu")
+	asrt.EqualError(err, "eu is ambiguous, it could be E-U or e.u")
+}
+
+func TestParseFuzzyInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := enum.ParseFuzzy(new(FuzzyRegion), "oceania")
+	asrt.EqualError(err, "oceania is not a valid enum")
+
+	_, err = enum.ParseFuzzy(new(FuzzyRegion), "ASIA")
+	asrt.EqualError(err, "ASIA is not a valid enum")
+
+	c, err := enum.ParseFuzzy(new(FuzzyRegion), "ASIA", enum.Lenient())
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("asia"), c)
+}
+
+type City struct {
+	enum.Enum
+	Zurich enum.Const `enum:"Zürich"`
+	Koln   enum.Const `enum:"Köln,alias=Koeln"`
+	Street enum.Const `enum:"Straße"`
+}
+
+func TestParseNormalize(t *testing.T) {
+	asrt := assert.New(t)
+
+	for _, s := range []string{"Zürich", "Zu\u0308rich", "ZÜRICH", "zu\u0308rich"} {
+		c, err := enum.Parse(new(City), s, enum.Normalize())
+		asrt.Nil(err, s)
+		asrt.Equal(enum.Const("Zürich"), c)
+	}
+
+	c, err := enum.Parse(new(City), "STRASSE", enum.Normalize())
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("Straße"), c)
+
+	c, err = enum.Parse(new(City), "KOELN", enum.Normalize())
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("Köln"), c)
+
+	_, err = enum.Parse(new(City), "Zu\u0308rich")
+	asrt.EqualError(err, "Zu\u0308rich is not a valid enum")
+
+	_, err = enum.Parse(new(City), "STRASSE", enum.CaseInsensitive())
+	asrt.EqualError(err, "STRASSE is not a valid enum")
+}
+
+func TestParseNormalizeConcurrent(t *testing.T) {
+	asrt := assert.New(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, s := range []string{"ZU\u0308RICH", "STRASSE", "köln"} {
+				_, err := enum.Parse(new(City), s, enum.Normalize())
+				asrt.Nil(err, s)
+			}
+		}()
+	}
+	wg.Wait()
+}
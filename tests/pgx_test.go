@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumpgx"
+	"testing"
+)
+
+const tenderOID = 90000
+
+func tenderMap(t *testing.T) *pgtype.Map {
+	codec, err := enumpgx.NewCodec(new(Tender))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := pgtype.NewMap()
+	m.RegisterType(&pgtype.Type{Name: "tender", OID: tenderOID, Codec: codec})
+	return m
+}
+
+func TestPgxEncode(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := tenderMap(t)
+	cash := enum.MustConstruct(new(Tender), "CASH").(*Tender)
+
+	for _, v := range []interface{}{cash, *cash, cash.Cash, "CASH"} {
+		buf, err := m.Encode(tenderOID, pgtype.BinaryFormatCode, v, nil)
+		asrt.Nil(err)
+		asrt.Equal("CASH", string(buf))
+	}
+
+	_, err := m.Encode(tenderOID, pgtype.TextFormatCode, enum.Const("IOU"), nil)
+	asrt.ErrorContains(err, "IOU is not a valid enum")
+
+	_, err = m.Encode(tenderOID, pgtype.BinaryFormatCode, enum.Const("CHEQUE"), nil)
+	asrt.ErrorContains(err, "CHEQUE has been retired")
+
+	buf, err := m.Encode(tenderOID, pgtype.BinaryFormatCode, new(Tender), nil)
+	asrt.Nil(err)
+	asrt.Nil(buf)
+}
+
+func TestPgxScan(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := tenderMap(t)
+
+	var tender Tender
+	asrt.Nil(m.Scan(tenderOID, pgtype.BinaryFormatCode, []byte("CASH"), &tender))
+	asrt.Equal(enum.Const("CASH"), tender.Get())
+	asrt.Equal(enum.Const("CASH"), tender.Cash)
+
+	asrt.Nil(m.Scan(tenderOID, pgtype.TextFormatCode, []byte("CHEQUE"), &tender))
+	asrt.True(tender.IsRetired())
+
+	var ptr *Tender
+	asrt.Nil(m.Scan(tenderOID, pgtype.BinaryFormatCode, []byte("CASH"), &ptr))
+	asrt.Equal(enum.Const("CASH"), ptr.Get())
+	asrt.Nil(m.Scan(tenderOID, pgtype.BinaryFormatCode, nil, &ptr))
+	asrt.Nil(ptr)
+
+	var s string
+	asrt.Nil(m.Scan(tenderOID, pgtype.TextFormatCode, []byte("CASH"), &s))
+	asrt.Equal("CASH", s)
+
+	err := m.Scan(tenderOID, pgtype.BinaryFormatCode, []byte("IOU"), &tender)
+	asrt.ErrorContains(err, "IOU is not a valid enum")
+
+	err = m.Scan(tenderOID, pgtype.BinaryFormatCode, nil, &tender)
+	asrt.ErrorContains(err, "cannot scan NULL")
+}
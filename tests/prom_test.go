@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumprom"
+	"strings"
+	"testing"
+)
+
+type promStatus struct {
+	enum.Enum
+	Pending enum.Const
+	Shipped enum.Const
+	Lost    enum.Const `deprecated:"use Pending"`
+	Burnt   enum.Const `retired:""`
+}
+
+func TestLabelValues(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Equal([]string{"Pending", "Shipped", "Lost"}, enum.LabelValues(new(promStatus)))
+	asrt.Panics(func() { enum.LabelValues(new(duplicateCode)) })
+}
+
+func TestCurryByEnum(t *testing.T) {
+	asrt := assert.New(t)
+
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "orders_total", Help: "Orders."}, []string{"status"})
+	byStatus, err := enumprom.CurryByEnum(counter, "status", new(promStatus))
+	asrt.Nil(err)
+	asrt.Len(byStatus, 3)
+	byStatus["Shipped"].WithLabelValues().Inc()
+
+	asrt.Nil(testutil.CollectAndCompare(counter, strings.NewReader(`
+# HELP orders_total Orders.
+# TYPE orders_total counter
+orders_total{status="Lost"} 0
+orders_total{status="Pending"} 0
+orders_total{status="Shipped"} 1
+`)))
+
+	hist := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "order_seconds"}, []string{"region", "status"})
+	byObserver, err := enumprom.CurryByEnum[prometheus.ObserverVec](hist, "status", new(promStatus))
+	asrt.Nil(err)
+	byObserver["Pending"].WithLabelValues("eu").Observe(1)
+	asrt.Equal(1, testutil.CollectAndCount(hist))
+
+	_, err = enumprom.CurryByEnum(counter, "region", new(promStatus))
+	asrt.NotNil(err)
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum/enumproto"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+	"testing"
+)
+
+func enumValue(name string, number int32, deprecated bool) *descriptorpb.EnumValueDescriptorProto {
+	v := &descriptorpb.EnumValueDescriptorProto{Name: proto.String(name), Number: proto.Int32(number)}
+	if deprecated {
+		v.Options = &descriptorpb.EnumValueOptions{Deprecated: proto.Bool(true)}
+	}
+	return v
+}
+
+func generateProto(t *testing.T, file *descriptorpb.FileDescriptorProto) *pluginpb.CodeGeneratorResponse {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.GetName()},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{file},
+	}
+	gen, err := protogen.Options{}.New(req)
+	assert.Nil(t, err)
+	assert.Nil(t, enumproto.Generate(gen))
+	return gen.Response()
+}
+
+func TestProtoGenerate(t *testing.T) {
+	asrt := assert.New(t)
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("orders/order.proto"),
+		Package: proto.String("orders"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String("example.com/orders;orderspb")},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name:    proto.String("OrderStatus"),
+			Options: &descriptorpb.EnumOptions{AllowAlias: proto.Bool(true)},
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				enumValue("ORDER_STATUS_UNSPECIFIED", 0, false),
+				enumValue("ORDER_STATUS_IN_PROGRESS", 1, false),
+				enumValue("ORDER_STATUS_STARTED", 1, false),
+				enumValue("ORDER_STATUS_LEGACY", 2, true),
+			},
+		}},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Shipment"),
+			EnumType: []*descriptorpb.EnumDescriptorProto{{
+				Name:  proto.String("Carrier"),
+				Value: []*descriptorpb.EnumValueDescriptorProto{enumValue("UPS", 0, false)},
+			}},
+		}},
+	}
+
+	resp := generateProto(t, file)
+	asrt.Nil(resp.Error)
+	asrt.Len(resp.File, 1)
+	asrt.Equal("example.com/orders/order.goenum.go", resp.File[0].GetName())
+	asrt.Equal(`// Code generated by protoc-gen-goenum. DO NOT EDIT.
+// source: orders/order.proto
+
+package orderspb
+
+import enum "go-enum"
+
+type OrderStatusEnum struct {
+	enum.Enum
+	Unspecified enum.Const `+"`"+`enum:"ORDER_STATUS_UNSPECIFIED" code:"0"`+"`"+`
+	InProgress  enum.Const `+"`"+`enum:"ORDER_STATUS_IN_PROGRESS,alias=ORDER_STATUS_STARTED" code:"1"`+"`"+`
+	Legacy      enum.Const `+"`"+`enum:"ORDER_STATUS_LEGACY" code:"2" deprecated:""`+"`"+`
+}
+
+type Shipment_CarrierEnum struct {
+	enum.Enum
+	Ups enum.Const `+"`"+`enum:"UPS" code:"0"`+"`"+`
+}
+`, resp.File[0].GetContent())
+}
+
+func TestProtoGenerateWithoutEnums(t *testing.T) {
+	asrt := assert.New(t)
+
+	resp := generateProto(t, &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("empty.proto"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String("example.com/empty")},
+	})
+	asrt.Nil(resp.Error)
+	asrt.Len(resp.File, 0)
+}
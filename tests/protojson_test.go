@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type ProtoStatus struct {
+	enum.Enum      `format:"proto"`
+	Unspecified    enum.Const
+	PaymentPending enum.Const
+	Shipped        enum.Const `enum:"SHIPPED_OUT"`
+}
+
+type protoOrder struct {
+	Status  *ProtoStatus `json:"status"`
+	Omitted ProtoStatus  `json:"omitted,omitzero"`
+}
+
+func TestProtoJSONMarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	s := enum.MustConstruct(new(ProtoStatus), "PAYMENT_PENDING").(*ProtoStatus)
+	asrt.Equal([]enum.Const{"UNSPECIFIED", "PAYMENT_PENDING", "SHIPPED_OUT"}, s.GetAll())
+
+	b, err := json.Marshal(s)
+	asrt.Nil(err)
+	asrt.Equal(`"PAYMENT_PENDING"`, string(b))
+
+	b, err = json.Marshal(enum.New(new(ProtoStatus)))
+	asrt.Nil(err)
+	asrt.Equal(`"UNSPECIFIED"`, string(b))
+
+	code, ok := s.Code(s.Shipped)
+	asrt.True(ok)
+	asrt.Equal(2, code)
+
+	b, err = json.Marshal(protoOrder{Status: s, Omitted: *enum.New(new(ProtoStatus)).(*ProtoStatus)})
+	asrt.Nil(err)
+	asrt.Equal(`{"status":"PAYMENT_PENDING"}`, string(b))
+}
+
+func TestProtoJSONUnmarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	s := enum.New(new(ProtoStatus)).(*ProtoStatus)
+	asrt.Nil(json.Unmarshal([]byte(`"SHIPPED_OUT"`), s))
+	asrt.Equal(s.Shipped, s.Get())
+
+	asrt.Nil(json.Unmarshal([]byte(`1`), s))
+	asrt.Equal(s.PaymentPending, s.Get())
+
+	asrt.EqualError(json.Unmarshal([]byte(`7`), s), "7 is not a valid code for ProtoStatus")
+}
+
+func TestProtoJSONUnmarshalUnconstructed(t *testing.T) {
+	asrt := assert.New(t)
+
+	var o protoOrder
+	asrt.Nil(json.Unmarshal([]byte(`{"status":2,"omitted":"PAYMENT_PENDING"}`), &o))
+	asrt.Nil(enum.ValidateAll(&o))
+	asrt.Equal(o.Status.Shipped, o.Status.Get())
+	asrt.Equal(o.Omitted.PaymentPending, o.Omitted.Get())
+
+	b, err := json.Marshal(o)
+	asrt.Nil(err)
+	asrt.Equal(`{"status":"SHIPPED_OUT","omitted":"PAYMENT_PENDING"}`, string(b))
+
+	o = protoOrder{}
+	asrt.Nil(json.Unmarshal([]byte(`{"status":7}`), &o))
+	asrt.EqualError(enum.Validate(o.Status), "7 is not a valid code for ProtoStatus")
+}
+
+func TestIsZero(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.True(enum.New(new(CurrencyCode)).(*CurrencyCode).IsZero())
+	asrt.False(enum.MustConstruct(new(CurrencyCode), "DIA").(*CurrencyCode).IsZero())
+	asrt.True(enum.MustConstruct(new(ProtoStatus), "UNSPECIFIED").(*ProtoStatus).IsZero())
+	asrt.False(enum.MustConstruct(new(ProtoStatus), "SHIPPED_OUT").(*ProtoStatus).IsZero())
+}
@@ -0,0 +1,29 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+	"testing/quick"
+)
+
+func TestArbitrary(t *testing.T) {
+	asrt := assert.New(t)
+
+	seen := make(map[enum.Const]bool)
+	f := func(a enum.Arbitrary[Month]) bool {
+		seen[a.Value.Get()] = true
+		return enum.Validate(&a.Value) == nil && a.Value.December == "December"
+	}
+
+	asrt.Nil(quick.Check(f, &quick.Config{MaxCount: 200}))
+	asrt.Len(seen, 12)
+}
+
+func TestArbitraryNotEnum(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.PanicsWithValue("tests.decodeTest is not an enum", func() {
+		_ = quick.Check(func(a enum.Arbitrary[decodeTest]) bool { return true }, nil)
+	})
+}
@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"math/rand"
+	"testing"
+)
+
+func TestRandom(t *testing.T) {
+	asrt := assert.New(t)
+
+	r := rand.New(rand.NewSource(1))
+	seen := make(map[enum.Const]bool)
+	for i := 0; i < 100; i++ {
+		seen[enum.Random(new(Month), r)] = true
+	}
+
+	asrt.Len(seen, 12)
+}
+
+func TestRandomSkipsDeprecated(t *testing.T) {
+	asrt := assert.New(t)
+
+	for i := 0; i < 20; i++ {
+		asrt.Equal(enum.Const("EUR"), enum.Random(new(LegacyCurrency), nil))
+	}
+}
+
+func TestRandomExcept(t *testing.T) {
+	asrt := assert.New(t)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		asrt.Equal(enum.Const("DIA"), enum.RandomExcept(new(CurrencyCode), r, "ASd"))
+	}
+	asrt.Equal(enum.Const(""), enum.RandomExcept(new(CurrencyCode), r, "ASd", "DIA"))
+}
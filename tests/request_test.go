@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"go-enum/enumchi"
+	"go-enum/enummux"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// Serves the Const read from the currency path parameter, or the error reading it.
+func currencyHandler(read func(e enum.Enummer, r *http.Request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+		if err := read(c, r); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(c.Get()))
+	}
+}
+
+func serveGet(h http.Handler, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func TestFromRequestPath(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := http.NewServeMux()
+	m.Handle("GET /currencies/{currency}", currencyHandler(func(e enum.Enummer, r *http.Request) error {
+		return enum.FromRequestPath(e, r, "currency", enum.CaseInsensitive())
+	}))
+	m.Handle("GET /missing/{currency}", currencyHandler(func(e enum.Enummer, r *http.Request) error {
+		return enum.FromRequestPath(e, r, "code")
+	}))
+
+	w := serveGet(m, "/currencies/dia")
+	asrt.Equal(http.StatusOK, w.Code)
+	asrt.Equal("DIA", w.Body.String())
+
+	w = serveGet(m, "/currencies/USD")
+	asrt.Equal(http.StatusBadRequest, w.Code)
+	asrt.Equal("currency: USD is not a valid enum\n", w.Body.String())
+
+	w = serveGet(m, "/missing/DIA")
+	asrt.Equal("code: no value was provided\n", w.Body.String())
+}
+
+func TestFromRequestPathChi(t *testing.T) {
+	asrt := assert.New(t)
+
+	r := chi.NewRouter()
+	r.Get("/currencies/{currency}", currencyHandler(func(e enum.Enummer, r *http.Request) error {
+		return enumchi.FromRequestPath(e, r, "currency")
+	}))
+
+	asrt.Equal("ASd", serveGet(r, "/currencies/ASd").Body.String())
+	asrt.Equal("currency: asd is not a valid enum, did you mean ASd?\n", serveGet(r, "/currencies/asd").Body.String())
+}
+
+func TestFromRequestPathMux(t *testing.T) {
+	asrt := assert.New(t)
+
+	r := mux.NewRouter()
+	r.Handle("/currencies/{currency}", currencyHandler(func(e enum.Enummer, r *http.Request) error {
+		return enummux.FromRequestPath(e, r, "currency")
+	}))
+
+	asrt.Equal("DIA", serveGet(r, "/currencies/DIA").Body.String())
+	asrt.Equal("currency: USD is not a valid enum\n", serveGet(r, "/currencies/USD").Body.String())
+}
+
+func TestFromQuery(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.MustConstruct(new(CurrencyCode), "ASd").(*CurrencyCode)
+
+	asrt.Nil(enum.FromQuery(c, url.Values{}, "currency"))
+	asrt.Equal(c.USD, c.Get())
+
+	asrt.Nil(enum.FromQuery(c, url.Values{"currency": {"dia"}}, "currency", enum.CaseInsensitive()))
+	asrt.Equal(c.DIA, c.Get())
+
+	err := enum.FromQuery(c, url.Values{"currency": {"USD"}}, "currency")
+	asrt.EqualError(err, "currency: USD is not a valid enum")
+	asrt.Equal(c.DIA, c.Get())
+
+	err = enum.FromQuery(c, url.Values{"currency": {""}}, "currency")
+	asrt.EqualError(err, "currency: no value was provided")
+}
+
+func TestFromQueryAll(t *testing.T) {
+	asrt := assert.New(t)
+
+	values, err := url.ParseQuery("currency=DIA,ASd&currency=DIA&currency=&other=USD")
+	asrt.Nil(err)
+
+	cs, err := enum.FromQueryAll(new(CurrencyCode), values, "currency")
+	asrt.Nil(err)
+	asrt.Equal([]enum.Const{"DIA", "ASd", "DIA"}, cs)
+
+	cs, err = enum.FromQueryAll(new(CurrencyCode), values, "missing")
+	asrt.Nil(err)
+	asrt.Nil(cs)
+
+	_, err = enum.FromQueryAll(new(CurrencyCode), url.Values{"currency": {"DIA, USD"}}, "currency")
+	asrt.EqualError(err, "currency: [1]: USD is not a valid enum")
+	invalid := enum.InvalidValuesIn(err)
+	asrt.Len(invalid, 1)
+	asrt.Equal("currency[1]", invalid[0].Field)
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestRestrict(t *testing.T) {
+	asrt := assert.New(t)
+
+	o := enum.MustConstruct(new(OrderStatus), "PENDING").(*OrderStatus)
+	r, err := enum.Restrict(o, o.Shipped, o.Cancelled)
+	asrt.Nil(err)
+
+	asrt.Equal([]enum.Const{"SHIPPED", "CANCELLED"}, r.GetAll())
+	asrt.Equal(enum.Const(""), r.Get())
+	asrt.Nil(r.Set(o.Shipped))
+	asrt.EqualError(r.Set(o.Delivered), "DELIVERED is not a valid enum")
+
+	d, _ := enum.Describe(r)
+	asrt.Equal("OrderStatus", d.Name())
+	c, _ := d.Lookup("SHIPPED")
+	asrt.Equal([]enum.Const{}, c.Transitions)
+}
+
+func TestRestrictKeepsValue(t *testing.T) {
+	asrt := assert.New(t)
+
+	o := enum.MustConstruct(new(OrderStatus), "SHIPPED").(*OrderStatus)
+	r, _ := enum.Restrict(o, o.Shipped, o.Delivered)
+
+	asrt.Equal(o.Shipped, r.Get())
+	asrt.Nil(r.Set(o.Delivered))
+	asrt.Equal(o.Shipped, o.Get())
+}
+
+func TestRestrictKeepsConfig(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Nil(enum.RegisterCodec(new(LegacyCode), isoNumeric{}))
+	defer enum.RegisterCodec(new(LegacyCode), nil)
+
+	c, err := enum.Restrict(new(LegacyCode), "EUR")
+	asrt.Nil(err)
+	asrt.Nil(json.Unmarshal([]byte(`"978"`), c))
+	asrt.Equal(enum.Const("EUR"), c.Get())
+	b, err := json.Marshal(c)
+	asrt.Nil(err)
+	asrt.Equal(`"978"`, string(b))
+
+	s, err := enum.Restrict(new(LooseStatus), "enabled")
+	asrt.Nil(err)
+	asrt.Nil(json.Unmarshal([]byte(`"Active"`), s))
+	asrt.Equal(enum.Const("ACTIVE"), s.Get())
+	asrt.Nil(s.Set("enabled"))
+	asrt.Equal(enum.Const("ACTIVE"), s.Get())
+	asrt.EqualError(s.Set("CLOSED"), "CLOSED is not a valid enum")
+
+	p, err := enum.Restrict(new(Priority), "HIGH")
+	asrt.Nil(err)
+	asrt.Nil(json.Unmarshal([]byte(`20`), p))
+	b, err = json.Marshal(p)
+	asrt.Nil(err)
+	asrt.Equal(`20`, string(b))
+}
+
+func TestRestrictInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := enum.Restrict(new(OrderStatus), "SHIPPED", "LOST")
+	asrt.EqualError(err, "LOST is not a valid enum")
+}
+
+func TestRestrictUnmarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	type Update struct {
+		Status *enum.Enum `json:"status"`
+	}
+
+	r, _ := enum.Restrict(new(OrderStatus), "SHIPPED", "CANCELLED")
+	u := Update{Status: r.(*enum.Enum)}
+
+	asrt.Nil(json.Unmarshal([]byte(`{"status":"CANCELLED"}`), &u))
+	asrt.Nil(enum.Validate(u.Status))
+
+	asrt.Nil(json.Unmarshal([]byte(`{"status":"PENDING"}`), &u))
+	asrt.EqualError(enum.Validate(u.Status), "PENDING is not a valid enum")
+}
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"log"
+	"testing"
+)
+
+type Tender struct {
+	enum.Enum
+	Cash   enum.Const `enum:"CASH"`
+	Card   enum.Const `enum:"CARD"`
+	Cheque enum.Const `enum:"CHEQUE" retired:"no longer accepted"`
+}
+
+func TestRetiredExcludedFromGetAll(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(Tender)).(*Tender)
+
+	asrt.Equal([]enum.Const{"CASH", "CARD"}, c.GetAll())
+	asrt.Equal([]enum.Const{"CHEQUE"}, c.Retired())
+}
+
+func TestRetiredCannotBeSet(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(Tender)).(*Tender)
+
+	asrt.Equal("CHEQUE has been retired", c.Set(c.Cheque).Error())
+}
+
+func TestRetiredValidate(t *testing.T) {
+	asrt := assert.New(t)
+
+	var buf bytes.Buffer
+	enum.SetLogger(log.New(&buf, "", 0))
+	defer enum.SetLogger(nil)
+
+	var c Tender
+	asrt.Nil(json.Unmarshal([]byte(`"CHEQUE"`), &c))
+
+	asrt.Equal("CHEQUE has been retired", enum.Validate(&c).Error())
+	asrt.Nil(enum.Validate(&c, enum.Lenient()))
+	asrt.True(c.IsRetired())
+	asrt.Equal("CHEQUE is retired: no longer accepted\n", buf.String())
+}
+
+func TestRetiredCannotBeMarshalled(t *testing.T) {
+	asrt := assert.New(t)
+
+	var c Tender
+	asrt.Nil(json.Unmarshal([]byte(`"CHEQUE"`), &c))
+	asrt.Nil(enum.Validate(&c, enum.Lenient()))
+
+	_, err := json.Marshal(c)
+	asrt.Error(err)
+	asrt.Contains(err.Error(), "cannot marshal CHEQUE, it has been retired")
+}
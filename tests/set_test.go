@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestSetAlgebra(t *testing.T) {
+	asrt := assert.New(t)
+
+	open, err := enum.NewSet(new(OrderStatus), "PENDING", "SHIPPED")
+	asrt.Nil(err)
+	done, err := enum.NewSet(new(OrderStatus), "SHIPPED", "DELIVERED")
+	asrt.Nil(err)
+
+	asrt.Equal([]enum.Const{"PENDING", "SHIPPED", "DELIVERED"}, open.Union(done).Values())
+	asrt.Equal([]enum.Const{"SHIPPED"}, open.Intersect(done).Values())
+	asrt.Equal([]enum.Const{"PENDING"}, open.Difference(done).Values())
+	asrt.Equal(2, open.Len())
+}
+
+func TestSetAddRemove(t *testing.T) {
+	asrt := assert.New(t)
+
+	var s enum.Set[OrderStatus]
+	asrt.False(s.Contains("PENDING"))
+
+	asrt.Nil(s.Add("CANCELLED", "PENDING"))
+	asrt.True(s.Contains("PENDING"))
+	asrt.Equal("[PENDING CANCELLED]", s.String())
+
+	asrt.EqualError(s.Add("SHIPPED", "LOST"), "LOST is not a valid enum")
+	asrt.False(s.Contains("SHIPPED"))
+
+	s.Remove("PENDING", "LOST")
+	asrt.Equal([]enum.Const{"CANCELLED"}, s.Values())
+}
+
+func TestSetAllOf(t *testing.T) {
+	asrt := assert.New(t)
+
+	m := enum.New(new(Month)).(*Month)
+	asrt.Equal(m.GetAll(), enum.AllOf(m).Values())
+
+	s := enum.AllOf(new(Tender))
+	asrt.False(s.Contains("CHEQUE"))
+	asrt.EqualError(s.Add("CHEQUE"), "CHEQUE has been retired")
+}
+
+func TestSetClone(t *testing.T) {
+	asrt := assert.New(t)
+
+	s, _ := enum.NewSet(new(OrderStatus), "PENDING")
+	c := s.Clone()
+	asrt.Nil(c.Add("SHIPPED"))
+
+	asrt.False(s.Contains("SHIPPED"))
+	asrt.True(c.Contains("SHIPPED"))
+}
+
+func TestSetJSON(t *testing.T) {
+	asrt := assert.New(t)
+
+	type Filter struct {
+		Statuses enum.Set[OrderStatus] `json:"statuses"`
+	}
+
+	var f Filter
+	asrt.Nil(json.Unmarshal([]byte(`{"statuses":["SHIPPED","PENDING"]}`), &f))
+	asrt.True(f.Statuses.Contains("PENDING"))
+
+	out, err := json.Marshal(f)
+	asrt.Nil(err)
+	asrt.JSONEq(`{"statuses":["PENDING","SHIPPED"]}`, string(out))
+
+	err = json.Unmarshal([]byte(`{"statuses":["LOST"]}`), &f)
+	asrt.EqualError(err, "LOST is not a valid enum")
+
+	out, _ = json.Marshal(Filter{})
+	asrt.JSONEq(`{"statuses":[]}`, string(out))
+}
+
+func TestSetDefined(t *testing.T) {
+	asrt := assert.New(t)
+
+	e, _ := Colors.New(Red)
+	s, err := enum.NewSet(e, "GREEN")
+	asrt.Nil(err)
+	asrt.True(s.Contains("GREEN"))
+	asrt.False(s.Contains("PURPLE"))
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestValidateSlice(t *testing.T) {
+	asrt := assert.New(t)
+
+	usd := enum.MustConstruct(new(CurrencyCode), "ASd").(*CurrencyCode)
+	var random CurrencyCode
+	asrt.Nil(random.UnmarshalJSON([]byte(`"Random"`)))
+
+	asrt.Nil(enum.ValidateSlice([]*CurrencyCode{usd, usd}))
+
+	err := enum.ValidateSlice([]*CurrencyCode{usd, &random, nil})
+	asrt.EqualError(err, "[1]: Random is not a valid enum")
+
+	err = enum.ValidateSlice([]CurrencyCode{random, *usd, random})
+	asrt.EqualError(err, "[0]: Random is not a valid enum; [2]: Random is not a valid enum")
+	invalid := enum.InvalidValuesIn(err)
+	asrt.Len(invalid, 2)
+	asrt.Equal("[2]", invalid[1].Field)
+}
+
+func TestParseSlice(t *testing.T) {
+	asrt := assert.New(t)
+
+	cs, err := enum.ParseSlice(new(CurrencyCode), []string{"DIA", "ASd"})
+	asrt.Nil(err)
+	asrt.Equal([]enum.Const{"DIA", "ASd"}, cs)
+
+	cs, err = enum.ParseSlice(new(CurrencyCode), []string{"DIA", "Random", "GBP"})
+	asrt.Nil(cs)
+	asrt.EqualError(err, "[1]: Random is not a valid enum; [2]: GBP is not a valid enum")
+
+	cs, err = enum.ParseSlice(new(CurrencyCode), []string{"dia"}, enum.CaseInsensitive())
+	asrt.Nil(err)
+	asrt.Equal([]enum.Const{"DIA"}, cs)
+}
+
+func TestStrings(t *testing.T) {
+	asrt := assert.New(t)
+
+	asrt.Equal([]string{"USD", "ASd"}, enum.Strings([]enum.Const{"USD", "ASd"}))
+	asrt.Equal([]string{}, enum.Strings(nil))
+}
@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"log/slog"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	asrt := assert.New(t)
+
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	cc := enum.MustConstruct(new(CurrencyCode), "DIA").(*CurrencyCode)
+
+	l.Info("paid", "currency", cc, "value", enum.ValueOf(cc))
+	asrt.Equal("msg=paid currency=DIA value=DIA\n", buf.String())
+
+	enum.SetLogGroup(true)
+	defer enum.SetLogGroup(false)
+
+	buf.Reset()
+	l.Info("paid", "currency", cc)
+	asrt.Equal("msg=paid currency.value=DIA currency.type=CurrencyCode currency.ordinal=1\n", buf.String())
+
+	buf.Reset()
+	l.Info("paid", "currency", new(CurrencyCode))
+	asrt.Equal("msg=paid currency=\"\"\n", buf.String())
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLoadFromFile(t *testing.T) {
+	asrt := assert.New(t)
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	txt := filepath.Join(dir, "regions.txt")
+	asrt.Nil(os.WriteFile(txt, []byte("us-east-1\n\neu-west-1\n"), 0644))
+	js := filepath.Join(dir, "regions.json")
+	asrt.Nil(os.WriteFile(js, []byte(`["us-east-1","eu-west-1"]`), 0644))
+
+	for _, path := range []string{txt, js} {
+		regions, err := enum.LoadFrom(ctx, "Region", enum.FileSource(path))
+		asrt.Nil(err)
+		asrt.Equal([]enum.Const{"us-east-1", "eu-west-1"}, regions.GetAll())
+	}
+
+	asrt.Nil(os.WriteFile(txt, []byte("us-east-1\nap-south-1\n"), 0644))
+	regions, _ := enum.LoadFrom(ctx, "Region", enum.FileSource(txt))
+	asrt.EqualError(regions.Set("eu-west-1"), "eu-west-1 is not a valid enum")
+}
+
+func TestLoadFromHTTP(t *testing.T) {
+	asrt := assert.New(t)
+
+	body := `["us-east-1","eu-west-1"]`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/regions" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	regions, err := enum.LoadFrom(ctx, "Region", enum.HTTPSource(srv.Client(), srv.URL+"/regions"))
+	asrt.Nil(err)
+	asrt.Nil(regions.Set("eu-west-1"))
+
+	body = `["us-east-1","ap-south-1"]`
+	asrt.Nil(regions.Refresh(ctx))
+	asrt.Equal([]enum.Const{"us-east-1", "ap-south-1"}, regions.GetAll())
+	asrt.EqualError(enum.Validate(regions), "eu-west-1 is not a valid enum")
+
+	_, err = enum.LoadFrom(ctx, "Region", enum.HTTPSource(srv.Client(), srv.URL+"/missing"))
+	asrt.ErrorContains(err, "returned 404 Not Found")
+}
+
+func TestRemoteEnumPoll(t *testing.T) {
+	asrt := assert.New(t)
+
+	var version atomic.Int32
+	src := enum.SourceFunc(func(ctx context.Context) ([]enum.Const, error) {
+		if version.Load() == 0 {
+			return []enum.Const{"A", "B"}, nil
+		}
+		return []enum.Const{"A", "B", "C"}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	letters, err := enum.LoadFrom(ctx, "Letter", src)
+	asrt.Nil(err)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		letters.Poll(ctx, time.Millisecond)
+	}()
+
+	version.Store(1)
+	asrt.Eventually(func() bool {
+		all := letters.GetAll()
+		// Every snapshot is whole, never a mix of the old and new values
+		asrt.True(len(all) == 2 || len(all) == 3)
+		return len(all) == 3
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	wg.Wait()
+}
+
+func TestRemoteEnumRefreshDuringSet(t *testing.T) {
+	asrt := assert.New(t)
+
+	var version atomic.Int32
+	src := enum.SourceFunc(func(ctx context.Context) ([]enum.Const, error) {
+		if version.Load()%2 == 0 {
+			return []enum.Const{"A", "B", "C", "D", "E", "F", "G", "H"}, nil
+		}
+		return []enum.Const{"A"}, nil
+	})
+
+	ctx := context.Background()
+	letters, err := enum.LoadFrom(ctx, "Letter", src)
+	asrt.Nil(err)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				version.Add(1)
+				asrt.Nil(letters.Refresh(ctx))
+			}
+		}
+	}()
+
+	for i := 0; i < 50000; i++ {
+		if err := letters.Set("H"); err != nil {
+			asrt.EqualError(err, "H is not a valid enum")
+		}
+		letters.Has("H")
+		letters.Names()
+		_ = enum.Validate(letters)
+	}
+	close(done)
+	wg.Wait()
+}
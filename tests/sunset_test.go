@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"log"
+	"testing"
+	"time"
+)
+
+type SunsetPlan struct {
+	enum.Enum
+	Legacy enum.Const `enum:"LEGACY" sunset:"2001-01-01"`
+	Basic  enum.Const `enum:"BASIC" sunset:"2999-01-01"`
+	Pro    enum.Const `enum:"PRO"`
+}
+
+type badSunset struct {
+	enum.Enum
+	Legacy enum.Const `sunset:"next year"`
+}
+
+func TestSunsetIgnored(t *testing.T) {
+	asrt := assert.New(t)
+
+	p := enum.New(new(SunsetPlan)).(*SunsetPlan)
+	asrt.Nil(p.Set(p.Legacy))
+	asrt.Nil(enum.Validate(p))
+
+	date, ok := p.Sunset(p.Legacy)
+	asrt.True(ok)
+	asrt.Equal(time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC), date)
+	_, ok = p.Sunset(p.Pro)
+	asrt.False(ok)
+
+	d, _ := enum.Describe(p)
+	c, _ := d.Lookup(p.Legacy)
+	asrt.Equal("2001-01-01", c.Sunset)
+}
+
+func TestSunsetWarn(t *testing.T) {
+	asrt := assert.New(t)
+
+	var buf bytes.Buffer
+	enum.SetLogger(log.New(&buf, "", 0))
+	defer enum.SetLogger(nil)
+	enum.SetSunsetPolicy(enum.SunsetWarn)
+	defer enum.SetSunsetPolicy(enum.SunsetIgnore)
+
+	p := enum.New(new(SunsetPlan)).(*SunsetPlan)
+	asrt.Nil(p.Set(p.Basic))
+	asrt.Empty(buf.String())
+	asrt.Nil(p.Set(p.Legacy))
+	asrt.Equal("LEGACY was sunset on 2001-01-01\n", buf.String())
+}
+
+func TestSunsetReject(t *testing.T) {
+	asrt := assert.New(t)
+
+	enum.SetSunsetPolicy(enum.SunsetReject)
+	defer enum.SetSunsetPolicy(enum.SunsetIgnore)
+
+	p := enum.New(new(SunsetPlan)).(*SunsetPlan)
+	asrt.EqualError(p.Set(p.Legacy), "LEGACY was sunset on 2001-01-01")
+	asrt.Nil(p.Set(p.Basic))
+
+	var stored SunsetPlan
+	asrt.Nil(stored.UnmarshalJSON([]byte(`"LEGACY"`)))
+	asrt.EqualError(enum.Validate(&stored), "LEGACY was sunset on 2001-01-01")
+	asrt.Nil(enum.Validate(&stored, enum.Lenient()))
+}
+
+func TestSunsetInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := enum.Construct(new(badSunset), "")
+	asrt.EqualError(err, `sunset tag on Legacy must be a date like 2006-01-02 but got "next year"`)
+}
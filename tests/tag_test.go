@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type TaggedCurrency struct {
+	enum.Enum
+	USD    enum.Const `enum:"USD,default,alias=dollar,alias=usd_legacy"`
+	Euro   enum.Const `enum:",display=The Euro,order=0"`
+	Custom enum.Const `enum:"CUSTOM,deprecated=use USD"`
+	DEM    enum.Const `enum:"DEM,retired"`
+}
+
+type unknownOption struct {
+	enum.Enum
+	USD enum.Const `enum:"USD,colour=green"`
+}
+
+type emptyAlias struct {
+	enum.Enum
+	USD enum.Const `enum:"USD,alias"`
+}
+
+type aliasClash struct {
+	enum.Enum
+	USD enum.Const `enum:"USD,alias=EUR"`
+	EUR enum.Const
+}
+
+type duplicateAlias struct {
+	enum.Enum
+	USD enum.Const `enum:"USD,alias=money"`
+	EUR enum.Const `enum:"EUR,alias=money"`
+}
+
+type badOrderOption struct {
+	enum.Enum
+	USD enum.Const `enum:"USD,order=first"`
+}
+
+type twoDefaults struct {
+	enum.Enum
+	USD enum.Const `enum:",default"`
+	EUR enum.Const `enum:",default"`
+}
+
+func TestTagOptions(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(TaggedCurrency)).(*TaggedCurrency)
+
+	asrt.Equal(enum.Const("USD"), c.Get())
+	def, ok := c.Default()
+	asrt.True(ok)
+	asrt.Equal(c.USD, def)
+
+	asrt.Equal(enum.Const("Euro"), c.Euro)
+	asrt.Equal([]enum.Const{"Euro", "USD"}, c.GetAll())
+	asrt.Equal([]enum.Const{"CUSTOM"}, c.Deprecated())
+	asrt.Equal([]enum.Const{"DEM"}, c.Retired())
+
+	d, _ := enum.Describe(c)
+	euro, _ := d.Lookup("Euro")
+	asrt.Equal("The Euro", euro.Display)
+	custom, _ := d.Lookup("CUSTOM")
+	asrt.Equal("use USD", custom.Deprecated)
+	usd, _ := d.Lookup("USD")
+	asrt.Equal([]enum.Const{"dollar", "usd_legacy"}, usd.Aliases)
+}
+
+func TestTagAliases(t *testing.T) {
+	asrt := assert.New(t)
+
+	v, err := enum.Parse(new(TaggedCurrency), "dollar")
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("USD"), v)
+
+	v, err = enum.Parse(new(TaggedCurrency), "USD_LEGACY", enum.CaseInsensitive())
+	asrt.Nil(err)
+	asrt.Equal(enum.Const("USD"), v)
+}
+
+func TestNoDefault(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.New(new(CurrencyCode)).(*CurrencyCode)
+	asrt.Equal(enum.Const(""), c.Get())
+	_, ok := c.Default()
+	asrt.False(ok)
+}
+
+func TestTagOptionErrors(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := enum.NewE(new(unknownOption))
+	asrt.EqualError(err, `enum tag on USD has unknown option "colour"`)
+
+	_, err = enum.NewE(new(emptyAlias))
+	asrt.EqualError(err, "alias option on USD needs a value")
+
+	_, err = enum.NewE(new(aliasClash))
+	asrt.EqualError(err, "alias EUR of USD is already a value of the enum")
+
+	_, err = enum.NewE(new(duplicateAlias))
+	asrt.EqualError(err, "alias money is used by both USD and EUR")
+
+	_, err = enum.NewE(new(badOrderOption))
+	asrt.EqualError(err, `order tag on USD must be an integer but got "first"`)
+
+	_, err = enum.NewE(new(twoDefaults))
+	asrt.EqualError(err, "USD and EUR are both marked default")
+}
@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"html/template"
+	"testing"
+)
+
+type TemplateCurrency struct {
+	enum.Enum
+	USD enum.Const `display:"US Dollar"`
+	GBP enum.Const `display:"Pound <£>"`
+	DEM enum.Const `deprecated:"use EUR"`
+	FRF enum.Const `retired:""`
+}
+
+type paymentForm struct {
+	Currency TemplateCurrency
+}
+
+func renderTemplate(t *testing.T, text string, data interface{}) string {
+	tmpl := template.Must(template.New("form").Funcs(enum.FuncMap()).Parse(text))
+	var b bytes.Buffer
+	assert.Nil(t, tmpl.Execute(&b, data))
+	return b.String()
+}
+
+func TestTemplateOptions(t *testing.T) {
+	asrt := assert.New(t)
+
+	form := &paymentForm{Currency: *enum.MustConstruct(new(TemplateCurrency), "GBP").(*TemplateCurrency)}
+	asrt.Equal(`<select><option value="USD">US Dollar</option><option value="GBP" selected>Pound &lt;£&gt;</option></select>`,
+		renderTemplate(t, `<select>{{enumOptions .Currency}}</select>`, form))
+
+	form.Currency.MustSet(form.Currency.DEM)
+	asrt.Equal(`<option value="USD">US Dollar</option><option value="GBP">Pound &lt;£&gt;</option><option value="DEM" selected>DEM</option>`,
+		renderTemplate(t, `{{enumOptions .Currency}}`, form))
+
+	asrt.Equal(`<option value="USD">US Dollar</option><option value="GBP">Pound &lt;£&gt;</option>`,
+		renderTemplate(t, `{{enumOptions .Currency}}`, &paymentForm{}))
+}
+
+func TestTemplateSelected(t *testing.T) {
+	asrt := assert.New(t)
+
+	form := &paymentForm{Currency: *enum.MustConstruct(new(TemplateCurrency), "USD").(*TemplateCurrency)}
+	asrt.Equal(`<option value="USD" selected><option value="GBP" >`,
+		renderTemplate(t, `{{range $c := .Values}}<option value="{{$c}}" {{enumSelected $.Form.Currency $c}}>{{end}}`,
+			map[string]interface{}{"Form": form, "Values": []enum.Const{"USD", "GBP"}}))
+	asrt.Equal(`selected`, renderTemplate(t, `{{enumSelected .Currency "USD"}}`, form))
+	asrt.Equal(``, renderTemplate(t, `{{enumSelected .Currency ""}}`, &paymentForm{}))
+}
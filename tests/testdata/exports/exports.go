@@ -0,0 +1,53 @@
+package exports
+
+import (
+	"go-enum"
+)
+
+// The currencies payments can be made in
+type Currency struct {
+	enum.Enum
+	USD  enum.Const `display:"US Dollar"`
+	EUR  enum.Const `desc:"Euro, used across the eurozone"`
+	DEM  enum.Const `deprecated:"use EUR"`
+	FRF  enum.Const `retired:""`
+	skip enum.Const
+}
+
+type Priority struct {
+	enum.Enum `format:"int" start:"1"`
+	Low       enum.Const `enum:"LOW"`
+	Medium    enum.Const `enum:"MEDIUM" retired:""`
+	High      enum.Const `enum:"HIGH"`
+}
+
+type Status struct {
+	enum.Enum `case:"snake"`
+	Shipped   enum.Const `order:"2"`
+	InTransit enum.Const `order:"1"`
+	Lost      enum.Const `enum:"LOST,deprecated=rarely happens,alias=MISSING,alias=GONE"`
+	Ignored   enum.Const `enum:"-"`
+}
+
+type PaymentCurrency struct {
+	enum.Enum
+	Currency
+	XAU enum.Const
+}
+
+type TierConst enum.Const
+
+func (TierConst) EnumConst() {}
+
+type Label string
+
+type Tier struct {
+	enum.Enum
+	Gold   TierConst
+	Silver TierConst
+	Label  Label
+}
+
+type notAnEnum struct {
+	Name string
+}
@@ -0,0 +1,74 @@
+package declarations
+
+import (
+	"go-enum"
+)
+
+type Valid struct {
+	enum.Enum
+	USD   enum.Const
+	Euro  enum.Const `enum:"EUR"`
+	label string
+}
+
+type NotFirst struct {
+	USD       enum.Const // want "enum.Enum should be the first field of NotFirst"
+	enum.Enum
+}
+
+type Duplicate struct {
+	enum.Enum
+	USD    enum.Const
+	Dollar enum.Const `enum:"USD"` // want "USD is also the value of USD"
+}
+
+type DuplicateCase struct {
+	enum.Enum `case:"lower"`
+	USD       enum.Const
+	Usd       enum.Const // want "usd is also the value of USD"
+}
+
+type EmptyTag struct {
+	enum.Enum
+	USD enum.Const `enum:""` // want "enum tag on USD is empty, remove it to use the field name"
+}
+
+type NotConst struct {
+	enum.Enum
+	USD enum.Const
+	EUR string    // want "EUR has type string rather than enum.Const"
+	GBP int       `enum:"GBP"` // want "GBP has type int rather than enum.Const"
+	Max int
+}
+
+type Helpers struct {
+	enum.Enum
+	USD     enum.Const
+	Default enum.Const `enum:"-"`
+	EUR     enum.Const `enum:"-"`
+	usd     enum.Const
+	Legacy  string     `enum:"-"`
+}
+
+type CurrencyConst enum.Const
+
+func (CurrencyConst) EnumConst() {}
+
+type Typed struct {
+	enum.Enum
+	USD    CurrencyConst
+	Dollar CurrencyConst `enum:"USD"` // want "USD is also the value of USD"
+}
+
+type Symbol string
+
+type Unmarked struct {
+	enum.Enum
+	USD    enum.Const
+	Symbol Symbol // want "Symbol has type Symbol which lacks the EnumConst method of enum.ConstMarker"
+}
+
+type notEnum struct {
+	USD enum.Const
+	EUR string
+}
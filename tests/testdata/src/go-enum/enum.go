@@ -0,0 +1,12 @@
+// A stub of go-enum for the analyzer tests.
+package enum
+
+type Const string
+
+type Enum struct {
+	val Const
+}
+
+func (e *Enum) Get() Const {
+	return e.val
+}
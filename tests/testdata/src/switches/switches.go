@@ -0,0 +1,89 @@
+package switches
+
+import (
+	"go-enum"
+)
+
+type Currency struct {
+	enum.Enum
+	USD enum.Const
+	EUR enum.Const `enum:"euro"`
+	DEM enum.Const `retired:""`
+	FRF enum.Const `enum:"FRF,retired=use euro"`
+	Any enum.Const `enum:"-"`
+	usd enum.Const
+}
+
+type Snake struct {
+	enum.Enum `case:"snake"`
+	UsDollar  enum.Const
+}
+
+type Payment struct {
+	enum.Enum
+	Currency
+	BTC enum.Const
+}
+
+func fields(c *Currency) {
+	switch c.Get() { // want "missing cases for Currency: euro"
+	case c.USD:
+	}
+
+	switch c.Get() {
+	case c.USD, c.EUR:
+	}
+}
+
+func literals(c Currency, s Snake) {
+	switch c.Get() {
+	case "USD", "euro":
+	}
+
+	switch c.Get() { // want "missing cases for Currency: USD, euro"
+	case "EUR":
+	}
+
+	switch s.Get() { // want "missing cases for Snake: us_dollar"
+	case "UsDollar":
+	}
+}
+
+func defaults(c *Currency) {
+	switch c.Get() { // want "missing cases for Currency: euro"
+	case c.USD:
+	default:
+	}
+}
+
+func composed(p *Payment) {
+	switch p.Get() { // want "missing cases for Payment: euro, BTC"
+	case p.USD:
+	}
+}
+
+func other(s string) {
+	switch s {
+	case "a":
+	}
+}
+
+type CurrencyConst enum.Const
+
+func (CurrencyConst) EnumConst() {}
+
+type Typed struct {
+	enum.Enum
+	USD CurrencyConst
+	EUR CurrencyConst
+}
+
+func typed(c *Typed) {
+	switch CurrencyConst(c.Get()) { // want "missing cases for Typed: EUR"
+	case c.USD:
+	}
+
+	switch CurrencyConst(c.Get()) {
+	case c.USD, c.EUR:
+	}
+}
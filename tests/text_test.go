@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"encoding"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+var _ encoding.TextMarshaler = enum.Enum{}
+var _ encoding.TextUnmarshaler = new(enum.Enum)
+
+func TestText(t *testing.T) {
+	asrt := assert.New(t)
+
+	tender := enum.MustConstruct(new(Tender), "CASH").(*Tender)
+	b, err := tender.MarshalText()
+	asrt.Nil(err)
+	asrt.Equal("CASH", string(b))
+
+	asrt.Nil(tender.UnmarshalText([]byte("CARD")))
+	asrt.Equal(tender.Card, tender.Get())
+	asrt.EqualError(tender.UnmarshalText([]byte("IOU")), "IOU is not a valid enum")
+	asrt.EqualError(tender.UnmarshalText([]byte("CHEQUE")), "CHEQUE has been retired")
+
+	var raw Tender
+	asrt.Nil(raw.UnmarshalText([]byte("IOU")))
+	asrt.EqualError(enum.Validate(&raw), "IOU is not a valid enum")
+}
+
+func TestKeyJSON(t *testing.T) {
+	asrt := assert.New(t)
+
+	var balances map[enum.Key[Tender]]int
+	asrt.Nil(json.Unmarshal([]byte(`{"CASH":5,"CARD":2}`), &balances))
+	asrt.Equal(5, balances[enum.Key[Tender]("CASH")])
+	asrt.Equal(enum.Const("CARD"), enum.Key[Tender]("CARD").Const())
+
+	out, err := json.Marshal(balances)
+	asrt.Nil(err)
+	asrt.JSONEq(`{"CASH":5,"CARD":2}`, string(out))
+
+	err = json.Unmarshal([]byte(`{"IOU":1}`), &balances)
+	asrt.EqualError(err, "IOU is not a valid enum")
+
+	err = json.Unmarshal([]byte(`{"CHEQUE":1}`), &balances)
+	asrt.EqualError(err, "CHEQUE has been retired")
+}
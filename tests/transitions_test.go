@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type OrderStatus struct {
+	enum.Enum
+	Pending   enum.Const `enum:"PENDING" transitions:"SHIPPED,CANCELLED"`
+	Shipped   enum.Const `enum:"SHIPPED" transitions:"DELIVERED"`
+	Delivered enum.Const `enum:"DELIVERED" transitions:""`
+	Cancelled enum.Const `enum:"CANCELLED"`
+}
+
+type JobStatus struct {
+	enum.Enum
+	Queued  enum.Const `enum:"QUEUED"`
+	Running enum.Const `enum:"RUNNING"`
+	Done    enum.Const `enum:"DONE"`
+}
+
+func (j JobStatus) Transitions() map[enum.Const][]enum.Const {
+	return map[enum.Const][]enum.Const{
+		j.Queued:  {j.Running},
+		j.Running: {j.Done},
+	}
+}
+
+func TestTransitionTag(t *testing.T) {
+	asrt := assert.New(t)
+
+	o := enum.MustConstruct(new(OrderStatus), "PENDING").(*OrderStatus)
+
+	asrt.Nil(o.Set(o.Shipped))
+	asrt.Nil(o.Set(o.Shipped))
+	asrt.Nil(o.Set(o.Delivered))
+
+	err := o.Set(o.Pending)
+	asrt.Equal(&enum.InvalidTransitionError{From: "DELIVERED", To: "PENDING"}, err)
+	asrt.Equal("cannot transition from DELIVERED to PENDING", err.Error())
+	asrt.Equal(o.Delivered, o.Get())
+}
+
+func TestTransitionUnrestrictedConst(t *testing.T) {
+	asrt := assert.New(t)
+
+	o := enum.MustConstruct(new(OrderStatus), "CANCELLED").(*OrderStatus)
+
+	asrt.Nil(o.Set(o.Delivered))
+}
+
+func TestTransitionHook(t *testing.T) {
+	asrt := assert.New(t)
+
+	j := enum.MustConstruct(new(JobStatus), "QUEUED").(*JobStatus)
+
+	asrt.IsType(new(enum.InvalidTransitionError), j.Set(j.Done))
+	asrt.Nil(j.Set(j.Running))
+	asrt.Nil(j.Set(j.Done))
+}
+
+func TestTransitionUnknownValue(t *testing.T) {
+	asrt := assert.New(t)
+
+	type Broken struct {
+		enum.Enum
+		A enum.Const `transitions:"C"`
+		B enum.Const
+	}
+
+	_, err := enum.Construct(new(Broken), "A")
+	asrt.Equal("transition from A references C which is not a valid enum", err.Error())
+}
+
+func TestDescribeTransitions(t *testing.T) {
+	asrt := assert.New(t)
+
+	d, err := enum.Describe(new(OrderStatus))
+	asrt.Nil(err)
+
+	c, _ := d.Lookup("PENDING")
+	asrt.Equal([]enum.Const{"SHIPPED", "CANCELLED"}, c.Transitions)
+	c, _ = d.Lookup("DELIVERED")
+	asrt.Equal([]enum.Const{}, c.Transitions)
+	c, _ = d.Lookup("CANCELLED")
+	asrt.Nil(c.Transitions)
+}
+
+func BenchmarkSetTransition(b *testing.B) {
+	o := enum.New(new(OrderStatus)).(*OrderStatus)
+	for i := 0; i < b.N; i++ {
+		o.MustSet(o.Pending)
+		_ = o.Set(o.Cancelled)
+		o.MustSet(o.Cancelled)
+	}
+}
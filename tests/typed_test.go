@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type CurrencyConst enum.Const
+
+func (CurrencyConst) EnumConst() {}
+
+type currencySymbol string
+
+type TypedCurrency struct {
+	enum.Enum
+	USD    CurrencyConst
+	Euro   CurrencyConst `enum:"EUR"`
+	Label  string
+	Symbol currencySymbol
+	Helper CurrencyConst `enum:"-"`
+}
+
+func TestNamedConstType(t *testing.T) {
+	asrt := assert.New(t)
+
+	c := enum.MustConstruct(new(TypedCurrency), "EUR").(*TypedCurrency)
+
+	asrt.Equal(CurrencyConst("USD"), c.USD)
+	asrt.Equal(CurrencyConst("EUR"), c.Euro)
+	asrt.Equal([]enum.Const{"USD", "EUR"}, c.GetAll())
+	asrt.Equal(c.Euro, enum.GetAs[CurrencyConst](c))
+
+	asrt.Nil(enum.SetAs(c, c.USD))
+	asrt.Equal(c.USD, enum.GetAs[CurrencyConst](c))
+	asrt.Equal("", c.Label)
+	asrt.Equal(currencySymbol(""), c.Symbol)
+	asrt.Equal(CurrencyConst(""), c.Helper)
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"go-enum/enumvalidator"
+	"testing"
+)
+
+type taggedPayment struct {
+	CurrencyCode CurrencyCode  `json:"currency_code" validate:"enum"`
+	Previous     *CurrencyCode `json:"previous" validate:"omitempty,enum"`
+}
+
+type untaggedPayment struct {
+	CurrencyCode CurrencyCode `json:"currency_code"`
+	Amount       int          `json:"amount"`
+}
+
+func TestValidatorTag(t *testing.T) {
+	asrt := assert.New(t)
+
+	v := validator.New()
+	asrt.Nil(enumvalidator.RegisterValidation(v))
+
+	var p taggedPayment
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"ASd","previous":"DIA"}`), &p))
+	asrt.Nil(v.Struct(&p))
+
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"ASd","previous":"USD"}`), &p))
+	err := v.Struct(&p)
+	asrt.Error(err)
+
+	errs := err.(validator.ValidationErrors)
+	asrt.Len(errs, 1)
+	asrt.Equal("Previous", errs[0].Field())
+	asrt.Equal("enum", errs[0].Tag())
+}
+
+func TestValidatorStructLevel(t *testing.T) {
+	asrt := assert.New(t)
+
+	v := validator.New()
+	asrt.Nil(enumvalidator.RegisterValidation(v, untaggedPayment{}))
+
+	var p untaggedPayment
+	asrt.Nil(json.Unmarshal([]byte(`{"currency_code":"USD","amount":5}`), &p))
+	err := v.Struct(&p)
+	asrt.Error(err)
+
+	errs := err.(validator.ValidationErrors)
+	asrt.Len(errs, 1)
+	asrt.Equal("CurrencyCode", errs[0].Field())
+	asrt.Equal("USD", errs[0].Param())
+}
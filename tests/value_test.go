@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+func TestValueOf(t *testing.T) {
+	asrt := assert.New(t)
+
+	a := enum.MustConstruct(new(Month), "May").(*Month)
+	b := enum.MustConstruct(new(Month), "May").(*Month)
+
+	v := enum.ValueOf(a)
+	asrt.True(v == enum.ValueOf(b))
+	asrt.Equal(enum.Const("May"), v.Const())
+	asrt.Equal(4, v.Ordinal())
+	asrt.Equal("Month", v.Descriptor().Name())
+	asrt.Equal("May", v.String())
+
+	b.MustSet(b.June)
+	asrt.False(v == enum.ValueOf(b))
+
+	seen := map[enum.Value]bool{v: true}
+	asrt.True(seen[enum.ValueOf(a)])
+}
+
+func TestValueInto(t *testing.T) {
+	asrt := assert.New(t)
+
+	v := enum.ValueOf(enum.MustConstruct(new(Month), "May").(*Month))
+
+	var m Month
+	asrt.Nil(v.Into(&m))
+	asrt.Equal(m.May, m.Get())
+
+	err := v.Into(new(Tender))
+	asrt.EqualError(err, "cannot convert a Month value into Tender")
+}
+
+func TestValueIntoInvalid(t *testing.T) {
+	asrt := assert.New(t)
+
+	var raw Tender
+	asrt.Nil(raw.UnmarshalJSON([]byte(`"CHEQUE"`)))
+	v := enum.ValueOf(&raw)
+
+	asrt.EqualError(v.Into(new(Tender)), "CHEQUE has been retired")
+}
+
+func TestValueMarshal(t *testing.T) {
+	asrt := assert.New(t)
+
+	v := enum.ValueOf(enum.MustConstruct(new(Month), "May").(*Month))
+	out, err := json.Marshal(map[string]enum.Value{"month": v})
+
+	asrt.Nil(err)
+	asrt.JSONEq(`{"month":"May"}`, string(out))
+}
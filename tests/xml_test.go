@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"encoding/xml"
+	"github.com/stretchr/testify/assert"
+	"go-enum"
+	"testing"
+)
+
+type AttrCurrency struct {
+	enum.Enum `enumxml:"attr,code"`
+	USD       enum.Const
+	EUR       enum.Const
+}
+
+type ElementCurrency struct {
+	enum.Enum `enumxml:"element"`
+	USD       enum.Const
+	EUR       enum.Const
+}
+
+type xmlPayment struct {
+	XMLName  xml.Name        `xml:"payment"`
+	Plain    CurrencyCode    `xml:"plain"`
+	Attr     AttrCurrency    `xml:"attr"`
+	Element  ElementCurrency `xml:"element"`
+	Refund   CurrencyCode    `xml:"refund,attr"`
+	Currency CurrencyCode    `xml:"currency,omitempty"`
+}
+
+func newXMLPayment() *xmlPayment {
+	p := new(xmlPayment)
+	enum.MustConstruct(&p.Plain, "DIA")
+	enum.MustConstruct(&p.Attr, "EUR")
+	enum.MustConstruct(&p.Element, "USD")
+	enum.MustConstruct(&p.Refund, "ASd")
+	return p
+}
+
+const xmlPaymentDoc = `<payment refund="ASd"><plain>DIA</plain><attr code="EUR"></attr><element><value>USD</value></element><currency></currency></payment>`
+
+func TestXMLPlacement(t *testing.T) {
+	asrt := assert.New(t)
+
+	out, err := xml.Marshal(newXMLPayment())
+	asrt.Nil(err)
+	asrt.Equal(xmlPaymentDoc, string(out))
+}
+
+func TestXMLUnmarshalPlacement(t *testing.T) {
+	asrt := assert.New(t)
+
+	p := new(xmlPayment)
+	enum.New(&p.Attr)
+	enum.New(&p.Element)
+	asrt.Nil(xml.Unmarshal([]byte(xmlPaymentDoc), p))
+	asrt.Equal(enum.Const("DIA"), p.Plain.Get())
+	asrt.Equal(p.Attr.EUR, p.Attr.Get())
+	asrt.Equal(p.Element.USD, p.Element.Get())
+	asrt.Equal(enum.Const("ASd"), p.Refund.Get())
+
+	err := xml.Unmarshal([]byte(`<payment><attr code="GBP"></attr></payment>`), p)
+	asrt.ErrorContains(err, "GBP is not a valid enum")
+}
+
+func TestXMLUnmarshalUnconstructed(t *testing.T) {
+	asrt := assert.New(t)
+
+	var p xmlPayment
+	asrt.Nil(xml.Unmarshal([]byte(xmlPaymentDoc), &p))
+	asrt.Equal(enum.Const("EUR"), p.Attr.Get())
+	asrt.Equal(enum.Const("USD"), p.Element.Get())
+	asrt.Nil(enum.Validate(&p.Attr))
+	asrt.Nil(enum.Validate(&p.Element))
+}
+
+type badPlacement struct {
+	enum.Enum `enumxml:"comment"`
+	USD       enum.Const
+}
+
+func TestXMLInvalidPlacement(t *testing.T) {
+	asrt := assert.New(t)
+
+	_, err := enum.NewE(new(badPlacement))
+	asrt.EqualError(err, `"comment" is not a valid enumxml placement, expected attr, chardata or element`)
+}
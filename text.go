@@ -0,0 +1,70 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+// Implements encoding.TextMarshaler, encoding the value through the enum's Codec if it has
+// one. Returns an error if the value has been retired
+func (e Enum) MarshalText() ([]byte, error) {
+	if e.desc != nil && e.desc.retired(e.val) {
+		return nil, errors.New(fmt.Sprintf(retiredMarshalErrorMsg, e.val))
+	}
+	if c, ok := e.desc.codec(); ok && e.val != "" {
+		return c.Encode(e.val)
+	}
+	return []byte(e.val), nil
+}
+
+// Implements encoding.TextUnmarshaler, decoding the text through the enum's Codec if it has
+// one. The value is validated straight away if the enum has been constructed, otherwise
+// enum.Validate must be run afterwards like with UnmarshalJSON
+func (e *Enum) UnmarshalText(b []byte) error {
+	v := Const(b)
+	if c, ok := e.desc.codec(); ok && len(b) > 0 {
+		var err error
+		if v, err = c.Decode(b); err != nil {
+			return err
+		}
+	}
+	if e.desc != nil {
+		v = e.desc.canonical(v)
+		if k := e.desc.lookup(v); k == nil || k.retired {
+			return e.desc.invalid(v)
+		}
+	}
+	e.unsafeSet(v)
+	return nil
+}
+
+// A Const of the enum type T which is validated whenever it is unmarshalled from text. Enum
+// structs can't be map keys so Key fills that role, most commonly for JSON objects keyed by
+// an enum. Like Set, T is the enum struct itself
+//   var balances map[enum.Key[CurrencyCodes]]int
+//   err := json.Unmarshal([]byte(`{"USD":5,"Random":1}`), &balances)
+//   fmt.Println(err.Error()) // Prints "Random is not a valid enum"
+type Key[T any] Const
+
+// The Const the key holds
+func (k Key[T]) Const() Const {
+	return Const(k)
+}
+
+func (k Key[T]) MarshalText() ([]byte, error) {
+	return []byte(k), nil
+}
+
+// Implements encoding.TextUnmarshaler. Returns an error if the text isn't a valid Const of T
+func (k *Key[T]) UnmarshalText(b []byte) error {
+	d, err := describeType[T](nil)
+	if err != nil {
+		return err
+	}
+	c := Const(b)
+	if k := d.lookup(c); k == nil || k.retired {
+		return d.invalid(c)
+	}
+	*k = Key[T](c)
+	return nil
+}
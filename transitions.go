@@ -0,0 +1,125 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"reflect"
+	"strings"
+)
+
+const invalidTransitionErrorMsg = "cannot transition from %s to %s"
+const unknownTransitionErrorMsg = "transition from %s references %s which is not a valid enum"
+
+// Implemented by enums that restrict which values can follow one another. Any Const missing
+// from the map can transition to any other value. The map takes precedence over the
+// transitions tag
+//   type OrderStatus struct {
+//     enum.Enum
+//     Pending   enum.Const `enum:"PENDING"`
+//     Shipped   enum.Const `enum:"SHIPPED"`
+//     Delivered enum.Const `enum:"DELIVERED"`
+//   }
+//
+//   func (o OrderStatus) Transitions() map[enum.Const][]enum.Const {
+//     return map[enum.Const][]enum.Const{
+//       o.Pending: {o.Shipped},
+//       o.Shipped: {o.Delivered},
+//       o.Delivered: {},
+//     }
+//   }
+type Transitioner interface {
+	Transitions() map[Const][]Const
+}
+
+// Returned by Set when the enum's current value cannot transition to the requested one
+type InvalidTransitionError struct {
+	From Const
+	To   Const
+}
+
+func (i *InvalidTransitionError) Error() string {
+	return fmt.Sprintf(invalidTransitionErrorMsg, i.From, i.To)
+}
+
+// Reads the transitions tag off each Const field then applies the enum's Transitioner hook
+// if it has one. A Const with an empty transitions tag cannot transition at all
+//   type OrderStatus struct {
+//     enum.Enum
+//     Pending   enum.Const `enum:"PENDING" transitions:"SHIPPED"`
+//     Shipped   enum.Const `enum:"SHIPPED" transitions:"DELIVERED"`
+//     Delivered enum.Const `enum:"DELIVERED" transitions:""`
+//   }
+func (d *Descriptor) buildTransitions(t reflect.Type) error {
+	for _, f := range d.fields {
+		tag, ok := t.FieldByIndex(f.index).Tag.Lookup("transitions")
+		if !ok {
+			continue
+		}
+		if d.transitions == nil {
+			d.transitions = make(map[Const][]Const)
+		}
+		next := make([]Const, 0)
+		for _, s := range strings.Split(tag, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				next = append(next, Const(s))
+			}
+		}
+		d.transitions[f.value] = next
+	}
+
+	inst := reflect.New(t)
+	for _, f := range d.fields {
+		inst.Elem().FieldByIndex(f.index).SetString(string(f.value))
+	}
+	if tr, ok := inst.Interface().(Transitioner); ok {
+		for from, next := range tr.Transitions() {
+			if d.transitions == nil {
+				d.transitions = make(map[Const][]Const)
+			}
+			d.transitions[from] = append([]Const{}, next...)
+		}
+	}
+
+	for from, next := range d.transitions {
+		if !d.has(from) {
+			return errors.New(fmt.Sprintf(unknownTransitionErrorMsg, from, from))
+		}
+		for _, to := range next {
+			if !d.has(to) {
+				return errors.New(fmt.Sprintf(unknownTransitionErrorMsg, from, to))
+			}
+		}
+	}
+	d.indexTransitions()
+	return nil
+}
+
+// Builds the sets checkTransition looks moves up in so Set doesn't scan the transitions.
+// Must be run whenever transitions changes.
+func (d *Descriptor) indexTransitions() {
+	d.allowed = nil
+	for from, next := range d.transitions {
+		if d.allowed == nil {
+			d.allowed = make(map[Const]map[Const]struct{}, len(d.transitions))
+		}
+		set := make(map[Const]struct{}, len(next))
+		for _, to := range next {
+			set[to] = struct{}{}
+		}
+		d.allowed[from] = set
+	}
+}
+
+func (d *Descriptor) checkTransition(from, to Const) error {
+	if from == "" || from == to || d.allowed == nil {
+		return nil
+	}
+	next, ok := d.allowed[from]
+	if !ok {
+		return nil
+	}
+	if _, ok := next[to]; ok {
+		return nil
+	}
+	return &InvalidTransitionError{From: from, To: to}
+}
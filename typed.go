@@ -0,0 +1,34 @@
+package enum
+
+// Implemented by named types based on Const, such as type CurrencyConst enum.Const, to have
+// fields of the type declare Consts. Reflection can't tell such a type apart from any other
+// named string type, so without the method its fields are left off the enum like any other
+// helper field
+//   type CurrencyConst enum.Const
+//
+//   func (CurrencyConst) EnumConst() {}
+type ConstMarker interface {
+	EnumConst()
+}
+
+// Gets the enum's value as T, for enums whose Consts are declared with a named type based on
+// Const so each enum has a distinct type, see ConstMarker
+//   type CurrencyConst enum.Const
+//
+//   func (CurrencyConst) EnumConst() {}
+//
+//   type CurrencyCodes struct {
+//     enum.Enum
+//     USD CurrencyConst
+//   }
+//
+//   if enum.GetAs[CurrencyConst](cc) == cc.USD { ... }
+func GetAs[T ~string](e Enummer) T {
+	return T(e.Get())
+}
+
+// Sets a value of type T on the enum, see GetAs and Set
+//   err := enum.SetAs(cc, cc.USD)
+func SetAs[T ~string](e Enummer, c T) error {
+	return e.Set(Const(c))
+}
@@ -0,0 +1,33 @@
+package enum
+
+import (
+	"reflect"
+)
+
+// Validates every enum reachable from v, which may be an enum itself or a struct, slice or
+// map holding them. Rather than stopping at the first invalid enum, all of them are
+// reported in a *MultiError with each error wrapped in a FieldError holding the JSON path
+// of the offending field
+//   err := enum.ValidateAll(&order)
+//   for _, e := range enum.InvalidValuesIn(err) {
+//     fmt.Println(e.Field, e.Value) // Prints "items[1].currency_code Random"
+//   }
+func ValidateAll(v interface{}, opts ...Option) error {
+	var errs []error
+	err := walk(reflect.ValueOf(v), "", jsonName, func(path string, e Enummer) error {
+		if err := Validate(e, opts...); err != nil {
+			if path != "" {
+				err = &FieldError{Field: path, Err: err}
+			}
+			errs = append(errs, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &MultiError{Errors: errs}
+}
@@ -0,0 +1,97 @@
+package enum
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+const valueMismatchErrorMsg = "cannot convert a %s value into %s"
+
+// A compact, comparable handle on an enum value holding only the enum's Descriptor and the
+// Const. Values of the same enum type compare equal with == when their Consts are equal,
+// so they can be passed around, stored in maps and sent in messages in place of the enum
+// struct
+//   v := enum.ValueOf(cc)
+//   if v == enum.ValueOf(other) { ... }
+type Value struct {
+	desc *Descriptor
+	c    Const
+}
+
+// Gets the Value for the enum's current value. Panics if the enum is declared incorrectly
+func ValueOf(e Enummer) Value {
+	v, err := ValueOfE(e)
+	if err != nil {
+		panic(err.Error())
+	}
+	return v
+}
+
+// Gets the Value for the enum's current value. Returns an error rather than panicking if e
+// is nil or declared incorrectly
+func ValueOfE(e Enummer) (Value, error) {
+	d, err := Describe(e)
+	if err != nil {
+		return Value{}, err
+	}
+	return Value{desc: d, c: e.Get()}, nil
+}
+
+// Stores the Value on e, the struct form of the same enum type, without the transition
+// checks or observers Set applies. Returns an error if the Value is of another type or
+// isn't valid on e
+//   cc := new(CurrencyCodes)
+//   err := v.Into(cc)
+func (v Value) Into(e Enummer) error {
+	if err := ensureConstructed(e); err != nil {
+		return err
+	}
+	d := e.base().desc
+	if v.desc != d {
+		name := "Enum"
+		if v.desc != nil {
+			name = v.desc.name
+		}
+		return errors.New(fmt.Sprintf(valueMismatchErrorMsg, name, d.name))
+	}
+	if v.c != "" {
+		if k := d.lookup(v.c); k == nil || k.retired {
+			return d.invalid(v.c)
+		}
+	}
+	e.unsafeSet(v.c)
+	return nil
+}
+
+// The Const the Value holds
+func (v Value) Const() Const {
+	return v.c
+}
+
+// The Descriptor of the Value's enum type, nil for the zero Value
+func (v Value) Descriptor() *Descriptor {
+	return v.desc
+}
+
+// The ordinal of the Value's Const or -1 if it isn't declared
+func (v Value) Ordinal() int {
+	if v.desc == nil {
+		return -1
+	}
+	return v.desc.index(v.c)
+}
+
+func (v Value) String() string {
+	return string(v.c)
+}
+
+func (v Value) MarshalText() ([]byte, error) {
+	if c, ok := v.desc.codec(); ok && v.c != "" {
+		return c.Encode(v.c)
+	}
+	return []byte(v.c), nil
+}
+
+func (v Value) MarshalJSON() ([]byte, error) {
+	return Enum{val: v.c, desc: v.desc}.marshalFormatted()
+}
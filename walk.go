@@ -0,0 +1,172 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var enummerType = reflect.TypeOf((*Enummer)(nil)).Elem()
+
+// Visits every Enummer reachable from v, passing fn the path of field names leading to it. Struct
+// fields are named by the provided name func, fields it returns "-" for are skipped. Map entries
+// are visited in the order of their keys and each pointer or map is only visited once, so
+// cyclic values terminate.
+func walk(v reflect.Value, path string, name func(f reflect.StructField) string, fn func(path string, e Enummer) error) error {
+	w := walker{name: name, fn: fn, seen: make(map[visit]bool)}
+	return w.walk(v, path)
+}
+
+// A pointer or map walk has already been through, along with its type as a struct and its
+// first field share an address.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// The state of a single walk.
+type walker struct {
+	name func(f reflect.StructField) string
+	fn   func(path string, e Enummer) error
+	seen map[visit]bool
+}
+
+func (w walker) walk(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Kind() == reflect.Ptr {
+			k := visit{ptr: v.Pointer(), typ: v.Type()}
+			if w.seen[k] {
+				return nil
+			}
+			w.seen[k] = true
+		}
+		return w.walk(v.Elem(), path)
+	case reflect.Struct:
+		if v.CanAddr() && v.Addr().Type().Implements(enummerType) {
+			return w.fn(path, v.Addr().Interface().(Enummer))
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath != "" && !f.Anonymous {
+				continue
+			}
+			p := path
+			if !promoted(f) {
+				n := w.name(f)
+				if n == "-" {
+					continue
+				}
+				p = joinPath(path, n)
+			}
+			if err := w.walk(v.Field(i), p); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := w.walk(v.Index(i), path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.IsNil() || !canHoldEnum(v.Type().Elem()) {
+			return nil
+		}
+		k := visit{ptr: v.Pointer(), typ: v.Type()}
+		if w.seen[k] {
+			return nil
+		}
+		w.seen[k] = true
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return mapKey(keys[i]) < mapKey(keys[j])
+		})
+		for _, k := range keys {
+			// Map values are not addressable so a copy is walked and stored back afterwards.
+			cp := reflect.New(v.Type().Elem()).Elem()
+			cp.Set(v.MapIndex(k))
+			err := w.walk(cp, path+"["+mapKey(k)+"]")
+			v.SetMapIndex(k, cp)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Whether values of type t can hold an Enummer, keyed by reflect.Type.
+var enumHolders sync.Map
+
+// Whether a value of type t can hold an Enummer, which an interface always might.
+func canHoldEnum(t reflect.Type) bool {
+	if out, ok := enumHolders.Load(t); ok {
+		return out.(bool)
+	}
+	out := holdsEnum(t, make(map[reflect.Type]bool))
+	enumHolders.Store(t, out)
+	return out
+}
+
+// See canHoldEnum. Types already being checked are skipped as recursive types would
+// otherwise never finish.
+func holdsEnum(t reflect.Type, checking map[reflect.Type]bool) bool {
+	if checking[t] {
+		return false
+	}
+	checking[t] = true
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return holdsEnum(t.Elem(), checking)
+	case reflect.Struct:
+		if reflect.PtrTo(t).Implements(enummerType) {
+			return true
+		}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if (f.PkgPath == "" || f.Anonymous) && holdsEnum(f.Type, checking) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Whether the field is an embedded struct whose fields are promoted into the parent.
+func promoted(f reflect.StructField) bool {
+	t := f.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return f.Anonymous && t.Kind() == reflect.Struct && f.Tag.Get("json") == ""
+}
+
+// Names struct fields the way encoding/json would.
+func jsonName(f reflect.StructField) string {
+	n := strings.Split(f.Tag.Get("json"), ",")[0]
+	if n == "" {
+		return f.Name
+	}
+	return n
+}
+
+// Appends name to the path, without a dot when name is an index such as [1].
+func joinPath(path, name string) string {
+	if path == "" || strings.HasPrefix(name, "[") {
+		return path + name
+	}
+	return path + "." + name
+}
+
+func mapKey(k reflect.Value) string {
+	return fmt.Sprint(k.Interface())
+}
@@ -0,0 +1,150 @@
+package enum
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+const unknownXMLPlacementErrorMsg = "%q is not a valid enumxml placement, expected attr, chardata or element"
+
+// Where an enum's value goes within its XML element, chosen with the enumxml tag on its Enum.
+type xmlPlacement int
+
+const (
+	chardataPlacement xmlPlacement = iota
+	attrPlacement
+	elementPlacement
+)
+
+// The placement of an enum's value in XML along with the name of the attribute or child
+// element holding it.
+type xmlLayout struct {
+	placement xmlPlacement
+	name      string
+}
+
+// Parses an enumxml tag, a placement optionally followed by the name to place the value under
+//   enum.Enum `enumxml:"attr,code"`
+func parseXMLLayout(tag string) (xmlLayout, error) {
+	placement, name, _ := strings.Cut(tag, ",")
+	l := xmlLayout{name: strings.TrimSpace(name)}
+	if l.name == "" {
+		l.name = "value"
+	}
+	switch strings.TrimSpace(placement) {
+	case "", "chardata":
+		l.placement = chardataPlacement
+	case "attr":
+		l.placement = attrPlacement
+	case "element":
+		l.placement = elementPlacement
+	default:
+		return l, errors.New(fmt.Sprintf(unknownXMLPlacementErrorMsg, placement))
+	}
+	return l, nil
+}
+
+// Implements xml.Marshaler. The value is written as the element's character data unless the
+// Enum is tagged enumxml:"attr" or enumxml:"element", which place it in an attribute or a
+// child element called value, or the name following the placement. An enum held in an
+// attribute of its parent, tagged xml:",attr", is always written as the bare value
+//   type CurrencyCodes struct {
+//     enum.Enum `enumxml:"attr,code"`
+//     USD enum.Const
+//   }
+//
+//   type Payment struct {
+//     Currency CurrencyCodes `xml:"currency"`
+//   }
+//
+//   out, _ := xml.Marshal(payment)
+//   fmt.Println(string(out)) // Prints <Payment><currency code="USD"></currency></Payment>
+func (e Enum) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	text, err := e.MarshalText()
+	if err != nil {
+		return err
+	}
+	l := e.desc.xmlLayout()
+	if l.placement == chardataPlacement {
+		return enc.EncodeElement(string(text), start)
+	}
+	if l.placement == attrPlacement && len(text) > 0 {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: l.name}, Value: string(text)})
+	}
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+	if l.placement == elementPlacement && len(text) > 0 {
+		if err := enc.EncodeElement(string(text), xml.StartElement{Name: xml.Name{Local: l.name}}); err != nil {
+			return err
+		}
+	}
+	return enc.EncodeToken(start.End())
+}
+
+// The parts of an XML element an enum's value may be found in.
+type xmlElement struct {
+	Attrs    []xml.Attr `xml:",any,attr"`
+	CharData string     `xml:",chardata"`
+	Children []struct {
+		XMLName  xml.Name
+		CharData string `xml:",chardata"`
+	} `xml:",any"`
+}
+
+// Implements xml.Unmarshaler, reading the value from where the enumxml tag places it. Until
+// the enum is constructed its placement is unknown, so the value is taken from the character
+// data, else the only attribute or child element. Like UnmarshalText, the value is validated
+// straight away if the enum has been constructed, otherwise enum.Validate must be run
+// afterwards
+func (e *Enum) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var el xmlElement
+	if err := d.DecodeElement(&el, &start); err != nil {
+		return err
+	}
+	if e.desc == nil {
+		return e.UnmarshalText([]byte(el.guess()))
+	}
+	l := e.desc.xmlLayout()
+	switch l.placement {
+	case attrPlacement:
+		for _, a := range el.Attrs {
+			if a.Name.Local == l.name {
+				return e.UnmarshalText([]byte(a.Value))
+			}
+		}
+		return nil
+	case elementPlacement:
+		for _, c := range el.Children {
+			if c.XMLName.Local == l.name {
+				return e.UnmarshalText([]byte(strings.TrimSpace(c.CharData)))
+			}
+		}
+		return nil
+	}
+	return e.UnmarshalText([]byte(strings.TrimSpace(el.CharData)))
+}
+
+// The value held by an element of an enum whose placement is unknown.
+func (el xmlElement) guess() string {
+	if s := strings.TrimSpace(el.CharData); s != "" {
+		return s
+	}
+	if len(el.Attrs) == 1 && len(el.Children) == 0 {
+		return el.Attrs[0].Value
+	}
+	if len(el.Children) == 1 && len(el.Attrs) == 0 {
+		return strings.TrimSpace(el.Children[0].CharData)
+	}
+	return ""
+}
+
+// The XML layout of the enum, character data if d is nil.
+func (d *Descriptor) xmlLayout() xmlLayout {
+	if d == nil {
+		return xmlLayout{placement: chardataPlacement, name: "value"}
+	}
+	return d.xml
+}